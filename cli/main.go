@@ -6,7 +6,7 @@ import (
 	"os"
 
 	"github.com/golang/glog"
-	"github.com/hobeone/gotrakt"
+	"github.com/hobeone/gotrakt/trakt"
 )
 
 func main() {
@@ -14,7 +14,7 @@ func main() {
 
 	flag.Set("logtostderr", "true")
 
-	apiKey := flag.String("apikey", "", "Trakt.TV API KEY")
+	apiKey := flag.String("apikey", "", "Trakt.TV API KEY (your application's Client ID)")
 
 	flag.Usage = func() {
 		fmt.Printf("Usage of %s:\n", os.Args[0])
@@ -35,7 +35,7 @@ func main() {
 		flag.Usage()
 	}
 
-	t, err := gotrakt.New(*apiKey)
+	t, err := trakt.New(*apiKey)
 	if err != nil {
 		glog.Fatalf("Error creating trakt client: %s\n", err)
 	}
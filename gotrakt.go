@@ -7,13 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"text/template"
 	"time"
 
 	"github.com/golang/glog"
 	"github.com/hobeone/gotrakt/httpclient"
-	"github.com/jmcvetta/napping"
 )
 
 //https://trakt.tv/api-docs/search-shows
@@ -49,10 +50,16 @@ const TraktTVBaseURL = "https://api.trakt.tv"
 type TraktTV struct {
 	APIKey   string
 	BaseURL  string
-	Session  *napping.Session
+	Client   Doer
 	Userinfo *url.Userinfo
 }
 
+// Doer sends HTTP requests.  *http.Client satisfies it, as does anything
+// wrapping one to add instrumentation or record requests in tests.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
 type option func(*TraktTV)
 
 // New initializes and returns a new TraktTV struct
@@ -60,13 +67,10 @@ func New(api string, options ...option) (*TraktTV, error) {
 	t := &TraktTV{
 		APIKey:  api,
 		BaseURL: TraktTVBaseURL,
-		Session: &napping.Session{
-			Log: false,
-			Client: httpclient.NewTimeoutClient(
-				httpclient.ConnectTimeout(10*time.Second),
-				httpclient.ReadWriteTimeout(10*time.Second),
-			),
-		},
+		Client: httpclient.NewTimeoutClient(
+			httpclient.ConnectTimeout(10*time.Second),
+			httpclient.ReadWriteTimeout(10*time.Second),
+		),
 	}
 	for _, opt := range options {
 		opt(t)
@@ -74,10 +78,10 @@ func New(api string, options ...option) (*TraktTV, error) {
 	return t, nil
 }
 
-// Session sets the session to use for talking to TraktTV
-func Session(sess *napping.Session) option {
+// Client sets the Doer used to send requests to TraktTV
+func Client(d Doer) option {
 	return func(t *TraktTV) {
-		t.Session = sess
+		t.Client = d
 	}
 }
 
@@ -103,16 +107,40 @@ func Userinfo(username, password string) option {
 
 func (t *TraktTV) getWithErrorCheck(url string, result interface{}) error {
 	glog.Infof("Get query for %s\n", url)
-	apiErr := &APIError{}
-	t.Session.Userinfo = t.Userinfo
-	response, err := t.Session.Get(url, &napping.Params{}, result, apiErr)
-	if serr, ok := err.(*json.SyntaxError); ok {
-		line, col, highlight := HighlightBytePosition(response.HttpResponse().Body, serr.Offset)
-		return fmt.Errorf("gotrackt: syntax error in response at line %d, column %d (file offset %d):\n%s", line, col, serr.Offset, highlight)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if t.Userinfo != nil {
+		password, _ := t.Userinfo.Password()
+		req.SetBasicAuth(t.Userinfo.Username(), password)
+	}
+
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
 	}
-	if apiErr.Status != "" {
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{}
+		json.Unmarshal(data, apiErr)
+		if apiErr.Status == "" {
+			apiErr.Status = "failure"
+			apiErr.ErrorDesc = resp.Status
+		}
 		return apiErr
 	}
+	err = json.Unmarshal(data, result)
+	if serr, ok := err.(*json.SyntaxError); ok {
+		line, col, highlight := HighlightBytePosition(bytes.NewReader(data), serr.Offset)
+		return fmt.Errorf("gotrackt: syntax error in response at line %d, column %d (file offset %d):\n%s", line, col, serr.Offset, highlight)
+	}
 	return err
 }
 
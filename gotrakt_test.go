@@ -8,26 +8,42 @@ import (
 	"os"
 	"strings"
 	"testing"
-
-	"github.com/jmcvetta/napping"
 )
 
+// recordingDoer records the requests sent through it
+type recordingDoer struct {
+	requests []*http.Request
+	client   *http.Client
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, req)
+	return d.client.Do(req)
+}
+
 func TestOptionSetting(t *testing.T) {
-	sess := &napping.Session{}
-	sess.Params = &napping.Params{
-		"testing": "true",
-	}
-	trakt, err := New("testingapi", Session(sess))
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	doer := &recordingDoer{client: http.DefaultClient}
+	trakt, err := New("testingapi", Client(doer), Host(ts.URL), Userinfo("user", "pass"))
 	if err != nil {
 		t.Fatalf("Unexpected error when creating new TraktTV: %s", err)
 	}
-	p := *trakt.Session.Params
-	if val, ok := p["testing"]; ok {
-		if val != "true" {
-			t.Fatalf("Expected value \"true\" got \"%s\"", val)
-		}
-	} else {
-		t.Fatalf("Didn't find \"testing\" key in the session params")
+	_, err = trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(doer.requests) != 1 {
+		t.Fatalf("Expected 1 request through the custom client, got %d", len(doer.requests))
+	}
+	user, _, ok := doer.requests[0].BasicAuth()
+	if !ok || user != "user" {
+		t.Fatalf("Expected basic auth for \"user\", got %q", user)
 	}
 }
 
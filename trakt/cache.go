@@ -0,0 +1,328 @@
+package trakt
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// CacheEntry is a cached response body along with what's needed to
+// revalidate it with Trakt.
+type CacheEntry struct {
+	Body         []byte      `json:"body"`
+	Header       http.Header `json:"header"`
+	ETag         string      `json:"etag"`
+	LastModified string      `json:"last_modified"`
+	// Expires is when the entry has to be revalidated before it can be
+	// used again.
+	Expires time.Time `json:"expires"`
+}
+
+// Cache stores responses to GET requests keyed by their URL.
+// Implementations must be safe to use from multiple goroutines.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// ResponseCache sets the cache GET responses are kept in.  Entries are
+// fresh for as long as Trakt's Cache-Control or Expires headers say, or the
+// matching CacheTTL override, and are revalidated with If-None-Match and
+// If-Modified-Since once they go stale.
+func ResponseCache(c Cache) option {
+	return func(t *TraktTV) {
+		t.cache = c
+	}
+}
+
+// CacheTTL overrides how long responses from an endpoint stay fresh,
+// ignoring what Trakt's headers say.  The endpoint is a path pattern as
+// written in the Trakt docs where segments starting with a colon match
+// anything, i.e. "/shows/:id/seasons/:season".
+func CacheTTL(endpoint string, ttl time.Duration) option {
+	return func(t *TraktTV) {
+		t.cacheTTLs = append(t.cacheTTLs, endpointTTL{
+			segments: strings.Split(strings.Trim(endpoint, "/"), "/"),
+			ttl:      ttl,
+		})
+	}
+}
+
+type endpointTTL struct {
+	segments []string
+	ttl      time.Duration
+}
+
+// matches reports if the url path matches the endpoint pattern
+func (e endpointTTL) matches(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != len(e.segments) {
+		return false
+	}
+	for i, s := range e.segments {
+		if !strings.HasPrefix(s, ":") && s != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheKey keys responses by URL and, for authenticated requests, the user
+// so one user's responses are never served to another.
+func cacheKey(url string, tok *Token) string {
+	if tok == nil {
+		return url
+	}
+	h := sha1.Sum([]byte(tok.AccessToken))
+	return url + "#" + hex.EncodeToString(h[:8])
+}
+
+// cacheExpiry works out when a response goes stale and whether it may be
+// stored at all.
+func (t *TraktTV) cacheExpiry(apiURL string, h http.Header, now time.Time) (time.Time, bool) {
+	cc := parseCacheControl(h.Get("Cache-Control"))
+	if _, ok := cc["no-store"]; ok {
+		return now, false
+	}
+	if u, err := url.Parse(apiURL); err == nil {
+		for _, e := range t.cacheTTLs {
+			if e.matches(u.Path) {
+				return now.Add(e.ttl), true
+			}
+		}
+	}
+	if _, ok := cc["no-cache"]; ok {
+		return now, true
+	}
+	if v, ok := cc["max-age"]; ok {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return now.Add(time.Duration(secs) * time.Second), true
+		}
+	}
+	if v := h.Get("Expires"); v != "" {
+		if at, err := http.ParseTime(v); err == nil {
+			return at, true
+		}
+	}
+	return now, true
+}
+
+func parseCacheControl(v string) map[string]string {
+	cc := map[string]string{}
+	for _, part := range strings.Split(v, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		key := strings.ToLower(kv[0])
+		if len(kv) == 2 {
+			cc[key] = strings.Trim(kv[1], `"`)
+		} else {
+			cc[key] = ""
+		}
+	}
+	return cc
+}
+
+// cachedGet answers a GET from the cache when the entry is fresh, and
+// otherwise makes a conditional request and updates the cache.
+func (t *TraktTV) cachedGet(ctx context.Context, url string, result interface{}, tok *Token) (http.Header, error) {
+	key := cacheKey(url, tok)
+	now := time.Now()
+	entry, cached := t.cache.Get(key)
+	if cached && now.Before(entry.Expires) {
+		glog.Infof("Cache hit for %s\n", url)
+		return entry.Header, decodeBody(url, entry.Body, result)
+	}
+
+	glog.Infof("GET query for %s\n", url)
+	extra := http.Header{}
+	if cached {
+		if entry.ETag != "" {
+			extra.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			extra.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+	resp, data, err := t.sendRateLimited(ctx, "GET", url, nil, tok, extra, t.rateLimitRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached {
+		entry.Expires, _ = t.cacheExpiry(url, resp.Header, now)
+		t.cache.Set(key, entry)
+		return entry.Header, decodeBody(url, entry.Body, result)
+	}
+	// A 304 without a cached copy, say because the entry was evicted
+	// while the request was in flight, has no body to decode
+	if resp.StatusCode == http.StatusNotModified || resp.StatusCode >= 400 {
+		return resp.Header, newHTTPError("GET", url, resp, data)
+	}
+
+	expires, storable := t.cacheExpiry(url, resp.Header, now)
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if storable && (expires.After(now) || etag != "" || lastModified != "") {
+		t.cache.Set(key, &CacheEntry{
+			Body:         data,
+			Header:       resp.Header,
+			ETag:         etag,
+			LastModified: lastModified,
+			Expires:      expires,
+		})
+	} else if cached {
+		t.cache.Delete(key)
+	}
+	return resp.Header, decodeBody(url, data, result)
+}
+
+// LRUCache is an in-memory Cache holding up to a fixed number of entries,
+// evicting the least recently used one when full.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an LRUCache holding at most maxEntries entries
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      map[string]*list.Element{},
+	}
+}
+
+// Get returns the entry for key and marks it as recently used
+func (c *LRUCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	e := *el.Value.(*lruItem).entry
+	return &e, true
+}
+
+// Set adds or replaces the entry for key, evicting the oldest entry if the
+// cache is full
+func (c *LRUCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruItem{key: key, entry: entry})
+	for c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete removes the entry for key
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// DiskCache is a Cache that keeps each entry as a JSON file in a
+// directory, so it survives restarts.
+type DiskCache struct {
+	Dir string
+	mu  sync.Mutex
+}
+
+// NewDiskCache returns a DiskCache storing entries in dir, creating it if
+// needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	return &DiskCache{Dir: dir}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	h := sha1.Sum([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(h[:])+".json")
+}
+
+// Get reads the entry for key.  Unreadable entries are treated as missing.
+func (c *DiskCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := &CacheEntry{}
+	if err := json.Unmarshal(b, entry); err != nil {
+		glog.Warningf("Ignoring corrupt cache entry for %s: %s", key, err)
+		return nil, false
+	}
+	return entry, true
+}
+
+// Set writes the entry for key.  Write failures are logged, since a cache
+// that can't be written to only costs extra requests.
+func (c *DiskCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, err := json.Marshal(entry)
+	if err == nil {
+		tmp := c.path(key) + ".tmp"
+		err = ioutil.WriteFile(tmp, b, 0600)
+		if err == nil {
+			err = os.Rename(tmp, c.path(key))
+		}
+	}
+	if err != nil {
+		glog.Warningf("Error writing cache entry for %s: %s", key, err)
+	}
+}
+
+// Delete removes the entry for key
+func (c *DiskCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	os.Remove(c.path(key))
+}
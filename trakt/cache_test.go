@@ -0,0 +1,165 @@
+package trakt
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	summary, err := ioutil.ReadFile("testdata/batman_movie_summary.json")
+	if err != nil {
+		t.Fatalf("Error reading test data: %s", err)
+	}
+	calls, notModified := 0, 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Cache-Control", "max-age=0")
+				w.Header().Set("ETag", `"v1"`)
+				if r.Header.Get("If-None-Match") == `"v1"` {
+					notModified++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				fmt.Fprintln(w, string(summary))
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), ResponseCache(NewLRUCache(10)))
+	for i := 0; i < 2; i++ {
+		m, err := trakt.GetMovie("batman-1989")
+		if err != nil {
+			t.Fatalf("Error getting movie: %s", err)
+		}
+		if m.Title != "Batman" {
+			t.Fatalf("Unexpected title on call %d: %s", i, m.Title)
+		}
+	}
+	if calls != 2 || notModified != 1 {
+		t.Fatalf("Expected the second call to be revalidated, got %d calls and %d 304s", calls, notModified)
+	}
+}
+
+func TestCacheNotModifiedWithoutEntry(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotModified)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), ResponseCache(NewLRUCache(10)))
+	_, err := trakt.GetMovie("batman-1989")
+	if !errors.Is(err, ErrNotModified) {
+		t.Fatalf("Expected ErrNotModified for a 304 with nothing cached, got %v", err)
+	}
+}
+
+func TestCacheHonorsMaxAge(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Cache-Control", "public, max-age=300")
+				fmt.Fprintln(w, `{"title":"Batman","year":1989}`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), ResponseCache(NewLRUCache(10)))
+	for i := 0; i < 3; i++ {
+		_, err := trakt.GetMovie("batman-1989")
+		if err != nil {
+			t.Fatalf("Error getting movie: %s", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("Expected 1 call with a fresh cache entry, got %d", calls)
+	}
+}
+
+func TestCacheTTLOverride(t *testing.T) {
+	calls := map[string]int{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls[r.URL.Path]++
+				w.Header().Set("Cache-Control", "no-cache")
+				fmt.Fprintln(w, `[]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL),
+		ResponseCache(NewLRUCache(10)),
+		CacheTTL("/shows/:id/seasons/:season", time.Hour),
+	)
+	for i := 0; i < 2; i++ {
+		_, err := trakt.ShowSeasons("battlestar-galactica-2003", []int{1})
+		if err != nil {
+			t.Fatalf("Error getting seasons: %s", err)
+		}
+		_, err = trakt.ShowSearch("battlestar")
+		if err != nil {
+			t.Fatalf("Error searching: %s", err)
+		}
+	}
+	if calls["/shows/battlestar-galactica-2003/seasons/1"] != 1 {
+		t.Fatalf("Expected the season to be fetched once, got %d", calls["/shows/battlestar-galactica-2003/seasons/1"])
+	}
+	if calls["/search/show"] != 2 {
+		t.Fatalf("Expected the search to be fetched twice, got %d", calls["/search/show"])
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", &CacheEntry{Body: []byte("a")})
+	c.Set("b", &CacheEntry{Body: []byte("b")})
+	c.Get("a")
+	c.Set("c", &CacheEntry{Body: []byte("c")})
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("Expected least recently used entry to be evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("Expected recently used entry to be kept")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Expected 2 entries, got %d", c.Len())
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotrakt")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("Error creating disk cache: %s", err)
+	}
+	expires := time.Now().Add(time.Hour).Round(time.Second)
+	c.Set("https://api.trakt.tv/shows/1", &CacheEntry{Body: []byte(`{"title":"x"}`), ETag: `"v1"`, Expires: expires})
+
+	// A second cache on the same directory sees the entry
+	c2, _ := NewDiskCache(dir)
+	e, ok := c2.Get("https://api.trakt.tv/shows/1")
+	if !ok {
+		t.Fatal("Expected to find the cached entry")
+	}
+	if string(e.Body) != `{"title":"x"}` || e.ETag != `"v1"` || !e.Expires.Equal(expires) {
+		t.Fatalf("Unexpected entry read back: %#v", e)
+	}
+	c2.Delete("https://api.trakt.tv/shows/1")
+	if _, ok := c.Get("https://api.trakt.tv/shows/1"); ok {
+		t.Fatal("Expected entry to be deleted")
+	}
+}
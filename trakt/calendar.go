@@ -0,0 +1,156 @@
+package trakt
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/calendars
+var CalendarTmpl = template.Must(
+	template.New("Calendar").Parse("{{.Host}}/calendars/{{.Calendar}}/{{.Kind}}/{{.Start}}/{{.Days}}"),
+)
+
+// Calendar says whose calendar to get
+type Calendar string
+
+// Calendars.  CalendarMy only has the shows the user watches and the movies
+// on their watchlist or collection, and needs the user's token.
+const (
+	CalendarAll Calendar = "all"
+	CalendarMy  Calendar = "my"
+)
+
+// DefaultCalendarDays is how many days a calendar covers when days isn't
+// given
+const DefaultCalendarDays = 7
+
+// CalendarShow is an episode airing on a calendar
+type CalendarShow struct {
+	FirstAired time.Time `json:"first_aired"`
+	Episode    Episode   `json:"episode"`
+	Show       Show      `json:"show"`
+}
+
+// CalendarMovie is a movie being released on a calendar
+type CalendarMovie struct {
+	// Released is the release date, at midnight UTC
+	Released time.Time
+	Movie    Movie
+}
+
+// UnmarshalJSON parses the release date, which Trakt sends without a time
+func (c *CalendarMovie) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Released string `json:"released"`
+		Movie    Movie  `json:"movie"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	c.Movie = raw.Movie
+	if raw.Released == "" {
+		c.Released = time.Time{}
+		return nil
+	}
+	released, err := time.Parse("2006-01-02", raw.Released)
+	if err != nil {
+		return err
+	}
+	c.Released = released
+	return nil
+}
+
+// ShowsCalendar returns the episodes airing in the days from start
+func (t *TraktTV) ShowsCalendar(cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	return t.ShowsCalendarContext(context.Background(), cal, start, days, opts...)
+}
+
+// ShowsCalendarContext is ShowsCalendar with a context that can cancel the
+// request
+func (t *TraktTV) ShowsCalendarContext(ctx context.Context, cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	res := []CalendarShow{}
+	err := t.calendar(ctx, cal, "shows", start, days, opts, &res)
+	return res, err
+}
+
+// NewShowsCalendar returns the first episodes of new shows airing in the
+// days from start
+func (t *TraktTV) NewShowsCalendar(cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	return t.NewShowsCalendarContext(context.Background(), cal, start, days, opts...)
+}
+
+// NewShowsCalendarContext is NewShowsCalendar with a context that can
+// cancel the request
+func (t *TraktTV) NewShowsCalendarContext(ctx context.Context, cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	res := []CalendarShow{}
+	err := t.calendar(ctx, cal, "shows/new", start, days, opts, &res)
+	return res, err
+}
+
+// SeasonPremieresCalendar returns the first episodes of seasons airing in
+// the days from start
+func (t *TraktTV) SeasonPremieresCalendar(cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	return t.SeasonPremieresCalendarContext(context.Background(), cal, start, days, opts...)
+}
+
+// SeasonPremieresCalendarContext is SeasonPremieresCalendar with a context
+// that can cancel the request
+func (t *TraktTV) SeasonPremieresCalendarContext(ctx context.Context, cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarShow, error) {
+	res := []CalendarShow{}
+	err := t.calendar(ctx, cal, "shows/premieres", start, days, opts, &res)
+	return res, err
+}
+
+// MoviesCalendar returns the movies released in the days from start
+func (t *TraktTV) MoviesCalendar(cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarMovie, error) {
+	return t.MoviesCalendarContext(context.Background(), cal, start, days, opts...)
+}
+
+// MoviesCalendarContext is MoviesCalendar with a context that can cancel
+// the request
+func (t *TraktTV) MoviesCalendarContext(ctx context.Context, cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarMovie, error) {
+	res := []CalendarMovie{}
+	err := t.calendar(ctx, cal, "movies", start, days, opts, &res)
+	return res, err
+}
+
+// DVDCalendar returns the movies released on DVD in the days from start
+func (t *TraktTV) DVDCalendar(cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarMovie, error) {
+	return t.DVDCalendarContext(context.Background(), cal, start, days, opts...)
+}
+
+// DVDCalendarContext is DVDCalendar with a context that can cancel the
+// request
+func (t *TraktTV) DVDCalendarContext(ctx context.Context, cal Calendar, start time.Time, days int, opts ...CallOption) ([]CalendarMovie, error) {
+	res := []CalendarMovie{}
+	err := t.calendar(ctx, cal, "dvd", start, days, opts, &res)
+	return res, err
+}
+
+// calendar gets one of the calendars.  The start date is taken in start's
+// location.
+func (t *TraktTV) calendar(ctx context.Context, cal Calendar, kind string, start time.Time, days int, opts []CallOption, result interface{}) error {
+	if days <= 0 {
+		days = DefaultCalendarDays
+	}
+	args := map[string]string{
+		"Calendar": string(cal),
+		"Kind":     kind,
+		"Start":    start.Format("2006-01-02"),
+		"Days":     strconv.Itoa(days),
+	}
+	apiURL, err := t.getURLWithQuery(CalendarTmpl, args, url.Values{"extended": {extendedArg(opts)}})
+	if err != nil {
+		return err
+	}
+	if cal == CalendarMy {
+		_, err = t.getAuthenticated(ctx, apiURL, result)
+	} else {
+		err = t.getWithErrorCheck(ctx, apiURL, result)
+	}
+	return err
+}
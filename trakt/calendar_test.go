@@ -0,0 +1,61 @@
+package trakt
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestShowsCalendar(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/calendars/my/shows/premieres/2014-09-01/3" || r.Header.Get("Authorization") != "Bearer access1" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"first_aired":"2014-09-01T09:00:00.000Z","episode":{"season":7,"number":1,"title":"Pilot","ids":{"trakt":1}},"show":{"title":"True Blood","year":2008,"ids":{"trakt":5}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	start := time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC)
+	cal, err := trakt.SeasonPremieresCalendar(CalendarMy, start, 3)
+	if err != nil {
+		t.Fatalf("Error getting calendar: %s", err)
+	}
+	if len(cal) != 1 || cal[0].Show.Title != "True Blood" || cal[0].Episode.Season != 7 ||
+		!cal[0].FirstAired.Equal(time.Date(2014, 9, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected calendar: %#v", cal)
+	}
+
+	anon, _ := New("testing", Host(ts.URL))
+	_, err = anon.ShowsCalendar(CalendarMy, start, 3)
+	if !errors.Is(err, ErrNotAuthenticated) {
+		t.Fatalf("Expected ErrNotAuthenticated for my calendar without a token, got %#v", err)
+	}
+}
+
+func TestMoviesCalendar(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/calendars/all/dvd/2014-08-01/7" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"released":"2014-08-01","movie":{"title":"Guardians of the Galaxy","year":2014,"ids":{"trakt":28}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	cal, err := trakt.DVDCalendar(CalendarAll, time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC), 0)
+	if err != nil {
+		t.Fatalf("Error getting calendar: %s", err)
+	}
+	if len(cal) != 1 || cal[0].Movie.Title != "Guardians of the Galaxy" ||
+		!cal[0].Released.Equal(time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected calendar: %#v", cal)
+	}
+}
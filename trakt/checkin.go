@@ -0,0 +1,109 @@
+package trakt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/checkin
+var CheckinTmpl = template.Must(
+	template.New("Checkin").Parse("{{.Host}}/checkin"),
+)
+
+// Sharing says which of the user's connected social accounts a check-in
+// is posted to
+type Sharing struct {
+	Twitter  bool `json:"twitter"`
+	Mastodon bool `json:"mastodon"`
+	Tumblr   bool `json:"tumblr"`
+}
+
+// CheckinOptions are the optional parts of a check-in
+type CheckinOptions struct {
+	// Message is posted with the check-in when sharing it
+	Message    string
+	Sharing    *Sharing
+	AppVersion string
+	AppDate    string
+}
+
+// CheckinResult is Trakt's answer to a check-in
+type CheckinResult struct {
+	ID        int64     `json:"id"`
+	WatchedAt time.Time `json:"watched_at"`
+	Sharing   Sharing   `json:"sharing"`
+	Movie     *Movie    `json:"movie"`
+	Show      *Show     `json:"show"`
+	Episode   *Episode  `json:"episode"`
+}
+
+// CheckinConflictError is returned by Checkin when the user is already
+// checked into something.  Cancel that check-in with CancelCheckin and try
+// again, or wait until ExpiresAt.
+type CheckinConflictError struct {
+	ExpiresAt time.Time  `json:"expires_at"`
+	Err       *HTTPError `json:"-"`
+}
+
+func (e *CheckinConflictError) Error() string {
+	return fmt.Sprintf("trakt: already checked in until %s", e.ExpiresAt.Format(time.RFC3339))
+}
+
+// Unwrap lets errors.Is match ErrConflict
+func (e *CheckinConflictError) Unwrap() error {
+	return e.Err
+}
+
+// Checkin checks the user into a movie or episode they're watching now.
+// opts may be nil.
+func (t *TraktTV) Checkin(media Media, opts *CheckinOptions) (*CheckinResult, error) {
+	return t.CheckinContext(context.Background(), media, opts)
+}
+
+// CheckinContext is Checkin with a context that can cancel the request
+func (t *TraktTV) CheckinContext(ctx context.Context, media Media, opts *CheckinOptions) (*CheckinResult, error) {
+	res := &CheckinResult{}
+	apiURL, err := t.getURLFromTemplate(CheckinTmpl, map[string]string{})
+	if err != nil {
+		return res, err
+	}
+	if opts == nil {
+		opts = &CheckinOptions{}
+	}
+	payload := struct {
+		mediaBody
+		Message    string   `json:"message,omitempty"`
+		Sharing    *Sharing `json:"sharing,omitempty"`
+		AppVersion string   `json:"app_version,omitempty"`
+		AppDate    string   `json:"app_date,omitempty"`
+	}{media.mediaBody(), opts.Message, opts.Sharing, opts.AppVersion, opts.AppDate}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, payload, res)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict {
+		conflict := &CheckinConflictError{Err: httpErr}
+		// The expiry is informational, so a failure here isn't an error
+		json.Unmarshal([]byte(httpErr.Body), conflict)
+		return res, conflict
+	}
+	return res, err
+}
+
+// CancelCheckin removes the user's active check-in, if any
+func (t *TraktTV) CancelCheckin() error {
+	return t.CancelCheckinContext(context.Background())
+}
+
+// CancelCheckinContext is CancelCheckin with a context that can cancel the
+// request
+func (t *TraktTV) CancelCheckinContext(ctx context.Context) error {
+	apiURL, err := t.getURLFromTemplate(CheckinTmpl, map[string]string{})
+	if err != nil {
+		return err
+	}
+	return t.sendAuthenticated(ctx, "DELETE", apiURL, nil, nil)
+}
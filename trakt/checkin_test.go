@@ -0,0 +1,83 @@
+package trakt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckin(t *testing.T) {
+	var posted map[string]interface{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/checkin" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"id":3373536619,"watched_at":"2014-08-06T06:54:36.859Z","sharing":{"twitter":true,"mastodon":false,"tumblr":false},"episode":{"season":1,"number":1,"title":"Pilot","ids":{"trakt":16}}}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	res, err := trakt.Checkin(&Episode{IDs: IDs{Trakt: 16}}, &CheckinOptions{
+		Message: "Watching the pilot",
+		Sharing: &Sharing{Twitter: true},
+	})
+	if err != nil {
+		t.Fatalf("Error checking in: %s", err)
+	}
+	if res.ID != 3373536619 || res.Episode == nil || res.Episode.Title != "Pilot" || !res.Sharing.Twitter {
+		t.Fatalf("Unexpected result: %#v", res)
+	}
+	if posted["message"] != "Watching the pilot" || posted["sharing"].(map[string]interface{})["twitter"] != true {
+		t.Fatalf("Unexpected body posted: %#v", posted)
+	}
+}
+
+func TestCheckinConflict(t *testing.T) {
+	cancelled := false
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "DELETE" {
+					cancelled = true
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				if cancelled {
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprintln(w, `{"id":1}`)
+					return
+				}
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintln(w, `{"expires_at":"2014-10-15T22:21:29.000Z"}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	movie := &Movie{IDs: IDs{Trakt: 224}}
+	_, err := trakt.Checkin(movie, nil)
+	var conflict *CheckinConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected a CheckinConflictError, got %#v", err)
+	}
+	if !conflict.ExpiresAt.Equal(time.Date(2014, 10, 15, 22, 21, 29, 0, time.UTC)) {
+		t.Fatalf("Unexpected expiry: %s", conflict.ExpiresAt)
+	}
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Expected errors.Is to match ErrConflict, got %s", err)
+	}
+
+	if err := trakt.CancelCheckin(); err != nil {
+		t.Fatalf("Error cancelling check-in: %s", err)
+	}
+	if _, err := trakt.Checkin(movie, nil); err != nil {
+		t.Fatalf("Expected check-in to succeed after cancelling, got %s", err)
+	}
+}
@@ -0,0 +1,226 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/get-collection
+var CollectionTmpl = template.Must(
+	template.New("Collection").Parse("{{.Host}}/sync/collection/{{.Type}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/add-to-collection
+var AddToCollectionTmpl = template.Must(
+	template.New("AddToCollection").Parse("{{.Host}}/sync/collection"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/remove-from-collection
+var RemoveFromCollectionTmpl = template.Must(
+	template.New("RemoveFromCollection").Parse("{{.Host}}/sync/collection/remove"),
+)
+
+// MediaType is the kind of copy of a movie or episode that's collected
+type MediaType string
+
+// Media types Trakt knows about
+const (
+	MediaDigital   MediaType = "digital"
+	MediaBluray    MediaType = "bluray"
+	MediaHDDVD     MediaType = "hddvd"
+	MediaDVD       MediaType = "dvd"
+	MediaVCD       MediaType = "vcd"
+	MediaVHS       MediaType = "vhs"
+	MediaBetamax   MediaType = "betamax"
+	MediaLaserdisc MediaType = "laserdisc"
+)
+
+// Resolution is the video resolution of a collected copy
+type Resolution string
+
+// Resolutions Trakt knows about
+const (
+	ResolutionUHD4K Resolution = "uhd_4k"
+	Resolution1080p Resolution = "hd_1080p"
+	Resolution1080i Resolution = "hd_1080i"
+	Resolution720p  Resolution = "hd_720p"
+	Resolution480p  Resolution = "sd_480p"
+	Resolution480i  Resolution = "sd_480i"
+	Resolution576p  Resolution = "sd_576p"
+	Resolution576i  Resolution = "sd_576i"
+)
+
+// HDR is the HDR format of a collected copy
+type HDR string
+
+// HDR formats Trakt knows about
+const (
+	HDRDolbyVision HDR = "dolby_vision"
+	HDR10          HDR = "hdr10"
+	HDR10Plus      HDR = "hdr10_plus"
+	HDRHLG         HDR = "hlg"
+)
+
+// Audio is the audio codec of a collected copy
+type Audio string
+
+// Audio codecs Trakt knows about
+const (
+	AudioLPCM              Audio = "lpcm"
+	AudioMP3               Audio = "mp3"
+	AudioMP2               Audio = "mp2"
+	AudioAAC               Audio = "aac"
+	AudioOGG               Audio = "ogg"
+	AudioOGGOpus           Audio = "ogg_opus"
+	AudioWMA               Audio = "wma"
+	AudioFLAC              Audio = "flac"
+	AudioDTS               Audio = "dts"
+	AudioDTSMA             Audio = "dts_ma"
+	AudioDTSHR             Audio = "dts_hr"
+	AudioDTSX              Audio = "dts_x"
+	AudioAuro3D            Audio = "auro_3d"
+	AudioDolbyDigital      Audio = "dolby_digital"
+	AudioDolbyDigitalPlus  Audio = "dolby_digital_plus"
+	AudioDolbyDigitalAtmos Audio = "dolby_digital_plus_atmos"
+	AudioDolbyAtmos        Audio = "dolby_atmos"
+	AudioDolbyTrueHD       Audio = "dolby_truehd"
+	AudioDolbyProLogic     Audio = "dolby_prologic"
+)
+
+// AudioChannels is the channel layout of a collected copy, i.e. "5.1".
+// Any layout Trakt accepts can be used, these are just the common ones.
+type AudioChannels string
+
+// Common channel layouts
+const (
+	AudioChannelsMono   AudioChannels = "1.0"
+	AudioChannelsStereo AudioChannels = "2.0"
+	AudioChannels51     AudioChannels = "5.1"
+	AudioChannels71     AudioChannels = "7.1"
+	AudioChannels714    AudioChannels = "7.1.4"
+)
+
+// Metadata describes a collected copy of a movie or episode.  Zero values
+// are left out of requests.
+type Metadata struct {
+	MediaType     MediaType     `json:"media_type,omitempty"`
+	Resolution    Resolution    `json:"resolution,omitempty"`
+	HDR           HDR           `json:"hdr,omitempty"`
+	Audio         Audio         `json:"audio,omitempty"`
+	AudioChannels AudioChannels `json:"audio_channels,omitempty"`
+	ThreeD        bool          `json:"3d,omitempty"`
+}
+
+// CollectMovie adds a movie collected at the given time with optional
+// metadata.  A zero time means now.
+func (s *SyncItems) CollectMovie(m *Movie, collectedAt time.Time, md *Metadata) *SyncItems {
+	s.Movies = append(s.Movies, SyncItem{IDs: m.IDs, CollectedAt: timeOrNil(collectedAt), Metadata: md})
+	return s
+}
+
+// CollectEpisode adds an episode, identified by the episode's IDs,
+// collected at the given time with optional metadata.
+func (s *SyncItems) CollectEpisode(e *Episode, collectedAt time.Time, md *Metadata) *SyncItems {
+	s.Episodes = append(s.Episodes, SyncItem{IDs: e.IDs, CollectedAt: timeOrNil(collectedAt), Metadata: md})
+	return s
+}
+
+// CollectShowEpisode adds an episode by its show's IDs and its season and
+// episode numbers, collected at the given time with optional metadata.
+func (s *SyncItems) CollectShowEpisode(showIDs IDs, season, episode int, collectedAt time.Time, md *Metadata) *SyncItems {
+	return s.addShowEpisode(showIDs, season, SyncShowEpisode{Number: episode, CollectedAt: timeOrNil(collectedAt), Metadata: md})
+}
+
+// CollectedMovie is a movie in the user's collection
+type CollectedMovie struct {
+	CollectedAt time.Time `json:"collected_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Movie       Movie     `json:"movie"`
+	Metadata    *Metadata `json:"metadata"`
+}
+
+// CollectedShow is a show with at least one episode in the user's
+// collection
+type CollectedShow struct {
+	LastCollectedAt time.Time         `json:"last_collected_at"`
+	LastUpdatedAt   time.Time         `json:"last_updated_at"`
+	Show            Show              `json:"show"`
+	Seasons         []CollectedSeason `json:"seasons"`
+}
+
+// CollectedSeason lists the collected episodes of a season
+type CollectedSeason struct {
+	Number   int                `json:"number"`
+	Episodes []CollectedEpisode `json:"episodes"`
+}
+
+// CollectedEpisode is a collected episode
+type CollectedEpisode struct {
+	Number      int       `json:"number"`
+	CollectedAt time.Time `json:"collected_at"`
+	Metadata    *Metadata `json:"metadata"`
+}
+
+// GetMovieCollection returns every movie in the user's collection.  The
+// items are ExtendedMetadata unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetMovieCollection(opts ...CallOption) ([]CollectedMovie, error) {
+	return t.GetMovieCollectionContext(context.Background(), opts...)
+}
+
+// GetMovieCollectionContext is GetMovieCollection with a context that can
+// cancel the request
+func (t *TraktTV) GetMovieCollectionContext(ctx context.Context, opts ...CallOption) ([]CollectedMovie, error) {
+	res := []CollectedMovie{}
+	err := t.collection(ctx, "movies", opts, &res)
+	return res, err
+}
+
+// GetShowCollection returns every show with collected episodes.  The items
+// are ExtendedMetadata unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetShowCollection(opts ...CallOption) ([]CollectedShow, error) {
+	return t.GetShowCollectionContext(context.Background(), opts...)
+}
+
+// GetShowCollectionContext is GetShowCollection with a context that can
+// cancel the request
+func (t *TraktTV) GetShowCollectionContext(ctx context.Context, opts ...CallOption) ([]CollectedShow, error) {
+	res := []CollectedShow{}
+	err := t.collection(ctx, "shows", opts, &res)
+	return res, err
+}
+
+// collection gets the user's collected movies or shows
+func (t *TraktTV) collection(ctx context.Context, kind string, opts []CallOption, result interface{}) error {
+	params := url.Values{"extended": {extendedArg(opts, ExtendedMetadata)}}
+	apiURL, err := t.getURLWithQuery(CollectionTmpl, map[string]string{"Type": kind}, params)
+	if err != nil {
+		return err
+	}
+	_, err = t.getAuthenticated(ctx, apiURL, result)
+	return err
+}
+
+// AddToCollection adds the items to the user's collection.  Items already
+// there have their metadata updated.
+func (t *TraktTV) AddToCollection(items *SyncItems) (*SyncResult, error) {
+	return t.AddToCollectionContext(context.Background(), items)
+}
+
+// AddToCollectionContext is AddToCollection with a context that can cancel
+// the request
+func (t *TraktTV) AddToCollectionContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, AddToCollectionTmpl, items)
+}
+
+// RemoveFromCollection removes the items from the user's collection
+func (t *TraktTV) RemoveFromCollection(items *SyncItems) (*SyncResult, error) {
+	return t.RemoveFromCollectionContext(context.Background(), items)
+}
+
+// RemoveFromCollectionContext is RemoveFromCollection with a context that
+// can cancel the request
+func (t *TraktTV) RemoveFromCollectionContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, RemoveFromCollectionTmpl, items)
+}
@@ -0,0 +1,101 @@
+package trakt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetShowCollection(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sync/collection/shows" || r.URL.Query().Get("extended") != "metadata" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"last_collected_at":"2014-09-01T09:10:11.000Z","last_updated_at":"2014-09-01T09:10:11.000Z","show":{"title":"Breaking Bad","year":2008,"ids":{"trakt":1}},"seasons":[{"number":1,"episodes":[{"number":1,"collected_at":"2014-09-01T09:10:11.000Z","metadata":{"media_type":"bluray","resolution":"hd_1080p","audio":"dts","audio_channels":"6.1","3d":false}}]}]}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	shows, err := trakt.GetShowCollection()
+	if err != nil {
+		t.Fatalf("Error getting collection: %s", err)
+	}
+	if len(shows) != 1 || shows[0].Show.Title != "Breaking Bad" || len(shows[0].Seasons) != 1 {
+		t.Fatalf("Unexpected collection: %#v", shows)
+	}
+	md := shows[0].Seasons[0].Episodes[0].Metadata
+	if md == nil || md.MediaType != MediaBluray || md.Resolution != Resolution1080p || md.AudioChannels != "6.1" {
+		t.Fatalf("Unexpected metadata: %#v", md)
+	}
+}
+
+func TestCollectionExtendedInfo(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sync/collection/movies" || r.URL.Query().Get("extended") != "metadata,images" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"collected_at":"2014-09-01T09:10:11.000Z","movie":{"title":"Batman","year":1989,"ids":{"trakt":224},"images":{"poster":["walter-r2.trakt.tv/images/movies/000/000/224/posters/medium/1.jpg"]}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	movies, err := trakt.GetMovieCollection(ExtendedInfo(ExtendedMetadata, ExtendedImages))
+	if err != nil {
+		t.Fatalf("Error getting collection: %s", err)
+	}
+	if len(movies) != 1 || movies[0].Movie.Images == nil || len(movies[0].Movie.Images.Poster) != 1 {
+		t.Fatalf("Unexpected collection: %#v", movies)
+	}
+}
+
+func TestAddToCollection(t *testing.T) {
+	var posted map[string][]map[string]interface{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/sync/collection" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"added":{"movies":1,"episodes":1},"updated":{"movies":0,"episodes":0},"existing":{"movies":0,"episodes":0}}`)
+			}))
+	defer ts.Close()
+
+	collected := time.Date(2014, 9, 1, 9, 10, 11, 0, time.UTC)
+	items := (&SyncItems{}).
+		CollectMovie(&Movie{IDs: IDs{Tmdb: 268}}, collected, &Metadata{
+			MediaType:     MediaBluray,
+			Resolution:    ResolutionUHD4K,
+			HDR:           HDRDolbyVision,
+			Audio:         AudioDolbyAtmos,
+			AudioChannels: AudioChannels714,
+			ThreeD:        true,
+		}).
+		CollectShowEpisode(IDs{Tvdb: 81189}, 1, 1, time.Time{}, nil)
+
+	trakt := authedClient(ts.URL)
+	res, err := trakt.AddToCollection(items)
+	if err != nil {
+		t.Fatalf("Error adding to collection: %s", err)
+	}
+	if res.Added.Movies != 1 || res.Added.Episodes != 1 {
+		t.Fatalf("Unexpected counts: %#v", res.Added)
+	}
+	movie := posted["movies"][0]
+	if movie["collected_at"] != "2014-09-01T09:10:11Z" || movie["resolution"] != "uhd_4k" ||
+		movie["hdr"] != "dolby_vision" || movie["audio_channels"] != "7.1.4" || movie["3d"] != true {
+		t.Fatalf("Expected metadata inline with the movie, got %#v", movie)
+	}
+	episode := posted["shows"][0]["seasons"].([]interface{})[0].(map[string]interface{})["episodes"].([]interface{})[0].(map[string]interface{})
+	if len(episode) != 1 || episode["number"] != float64(1) {
+		t.Fatalf("Expected just the episode number without metadata, got %#v", episode)
+	}
+}
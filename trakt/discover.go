@@ -0,0 +1,265 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+)
+
+// https://trakt.docs.apiary.io/#reference/movies/trending
+// https://trakt.docs.apiary.io/#reference/shows/trending
+var DiscoverTmpl = template.Must(
+	template.New("Discover").Parse("{{.Host}}/{{.Kind}}/{{.Feed}}{{if .Period}}/{{.Period | urlquery}}{{end}}"),
+)
+
+// Period is the time span the most played, watched and collected feeds
+// count over
+type Period string
+
+// Periods
+const (
+	PeriodDaily   Period = "daily"
+	PeriodWeekly  Period = "weekly"
+	PeriodMonthly Period = "monthly"
+	PeriodYearly  Period = "yearly"
+	PeriodAll     Period = "all"
+)
+
+// TrendingMovie is a movie being watched right now
+type TrendingMovie struct {
+	Watchers int   `json:"watchers"`
+	Movie    Movie `json:"movie"`
+}
+
+// TrendingShow is a show being watched right now
+type TrendingShow struct {
+	Watchers int  `json:"watchers"`
+	Show     Show `json:"show"`
+}
+
+// PlayedMovie is an entry in the most played, watched or collected movies
+type PlayedMovie struct {
+	WatcherCount   int   `json:"watcher_count"`
+	PlayCount      int   `json:"play_count"`
+	CollectedCount int   `json:"collected_count"`
+	Movie          Movie `json:"movie"`
+}
+
+// PlayedShow is an entry in the most played, watched or collected shows.
+// CollectedCount counts episodes and CollectorCount users.
+type PlayedShow struct {
+	WatcherCount   int  `json:"watcher_count"`
+	PlayCount      int  `json:"play_count"`
+	CollectedCount int  `json:"collected_count"`
+	CollectorCount int  `json:"collector_count"`
+	Show           Show `json:"show"`
+}
+
+// AnticipatedMovie is an upcoming movie and how many lists it's on
+type AnticipatedMovie struct {
+	ListCount int   `json:"list_count"`
+	Movie     Movie `json:"movie"`
+}
+
+// AnticipatedShow is an upcoming show and how many lists it's on
+type AnticipatedShow struct {
+	ListCount int  `json:"list_count"`
+	Show      Show `json:"show"`
+}
+
+// BoxOfficeMovie is one of the weekend's top grossing movies in the US
+type BoxOfficeMovie struct {
+	// Revenue is in US dollars
+	Revenue int64 `json:"revenue"`
+	Movie   Movie `json:"movie"`
+}
+
+// TrendingMovies returns the movies being watched the most right now
+func (t *TraktTV) TrendingMovies(opts PageOptions, callOpts ...CallOption) ([]TrendingMovie, *Pagination, error) {
+	return t.TrendingMoviesContext(context.Background(), opts, callOpts...)
+}
+
+// TrendingMoviesContext is TrendingMovies with a context that can cancel
+// the request
+func (t *TraktTV) TrendingMoviesContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]TrendingMovie, *Pagination, error) {
+	res := []TrendingMovie{}
+	p, err := t.discover(ctx, "movies", "trending", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// TrendingShows returns the shows being watched the most right now
+func (t *TraktTV) TrendingShows(opts PageOptions, callOpts ...CallOption) ([]TrendingShow, *Pagination, error) {
+	return t.TrendingShowsContext(context.Background(), opts, callOpts...)
+}
+
+// TrendingShowsContext is TrendingShows with a context that can cancel the
+// request
+func (t *TraktTV) TrendingShowsContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]TrendingShow, *Pagination, error) {
+	res := []TrendingShow{}
+	p, err := t.discover(ctx, "shows", "trending", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// PopularMovies returns the most popular movies, ranked by rating and
+// number of votes
+func (t *TraktTV) PopularMovies(opts PageOptions, callOpts ...CallOption) ([]Movie, *Pagination, error) {
+	return t.PopularMoviesContext(context.Background(), opts, callOpts...)
+}
+
+// PopularMoviesContext is PopularMovies with a context that can cancel the
+// request
+func (t *TraktTV) PopularMoviesContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]Movie, *Pagination, error) {
+	res := []Movie{}
+	p, err := t.discover(ctx, "movies", "popular", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// PopularShows returns the most popular shows, ranked by rating and number
+// of votes
+func (t *TraktTV) PopularShows(opts PageOptions, callOpts ...CallOption) ([]Show, *Pagination, error) {
+	return t.PopularShowsContext(context.Background(), opts, callOpts...)
+}
+
+// PopularShowsContext is PopularShows with a context that can cancel the
+// request
+func (t *TraktTV) PopularShowsContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]Show, *Pagination, error) {
+	res := []Show{}
+	p, err := t.discover(ctx, "shows", "popular", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostPlayedMovies returns the movies with the most plays in the period
+func (t *TraktTV) MostPlayedMovies(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	return t.MostPlayedMoviesContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostPlayedMoviesContext is MostPlayedMovies with a context that can
+// cancel the request
+func (t *TraktTV) MostPlayedMoviesContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	res := []PlayedMovie{}
+	p, err := t.discover(ctx, "movies", "played", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostWatchedMovies returns the movies with the most watchers in the
+// period
+func (t *TraktTV) MostWatchedMovies(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	return t.MostWatchedMoviesContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostWatchedMoviesContext is MostWatchedMovies with a context that can
+// cancel the request
+func (t *TraktTV) MostWatchedMoviesContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	res := []PlayedMovie{}
+	p, err := t.discover(ctx, "movies", "watched", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostCollectedMovies returns the movies collected the most in the period
+func (t *TraktTV) MostCollectedMovies(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	return t.MostCollectedMoviesContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostCollectedMoviesContext is MostCollectedMovies with a context that
+// can cancel the request
+func (t *TraktTV) MostCollectedMoviesContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedMovie, *Pagination, error) {
+	res := []PlayedMovie{}
+	p, err := t.discover(ctx, "movies", "collected", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostPlayedShows returns the shows with the most plays in the period
+func (t *TraktTV) MostPlayedShows(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	return t.MostPlayedShowsContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostPlayedShowsContext is MostPlayedShows with a context that can cancel
+// the request
+func (t *TraktTV) MostPlayedShowsContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	res := []PlayedShow{}
+	p, err := t.discover(ctx, "shows", "played", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostWatchedShows returns the shows with the most watchers in the period
+func (t *TraktTV) MostWatchedShows(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	return t.MostWatchedShowsContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostWatchedShowsContext is MostWatchedShows with a context that can
+// cancel the request
+func (t *TraktTV) MostWatchedShowsContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	res := []PlayedShow{}
+	p, err := t.discover(ctx, "shows", "watched", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// MostCollectedShows returns the shows collected the most in the period
+func (t *TraktTV) MostCollectedShows(period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	return t.MostCollectedShowsContext(context.Background(), period, opts, callOpts...)
+}
+
+// MostCollectedShowsContext is MostCollectedShows with a context that can
+// cancel the request
+func (t *TraktTV) MostCollectedShowsContext(ctx context.Context, period Period, opts PageOptions, callOpts ...CallOption) ([]PlayedShow, *Pagination, error) {
+	res := []PlayedShow{}
+	p, err := t.discover(ctx, "shows", "collected", period, opts, callOpts, &res)
+	return res, p, err
+}
+
+// AnticipatedMovies returns the upcoming movies on the most lists
+func (t *TraktTV) AnticipatedMovies(opts PageOptions, callOpts ...CallOption) ([]AnticipatedMovie, *Pagination, error) {
+	return t.AnticipatedMoviesContext(context.Background(), opts, callOpts...)
+}
+
+// AnticipatedMoviesContext is AnticipatedMovies with a context that can
+// cancel the request
+func (t *TraktTV) AnticipatedMoviesContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]AnticipatedMovie, *Pagination, error) {
+	res := []AnticipatedMovie{}
+	p, err := t.discover(ctx, "movies", "anticipated", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// AnticipatedShows returns the upcoming shows on the most lists
+func (t *TraktTV) AnticipatedShows(opts PageOptions, callOpts ...CallOption) ([]AnticipatedShow, *Pagination, error) {
+	return t.AnticipatedShowsContext(context.Background(), opts, callOpts...)
+}
+
+// AnticipatedShowsContext is AnticipatedShows with a context that can
+// cancel the request
+func (t *TraktTV) AnticipatedShowsContext(ctx context.Context, opts PageOptions, callOpts ...CallOption) ([]AnticipatedShow, *Pagination, error) {
+	res := []AnticipatedShow{}
+	p, err := t.discover(ctx, "shows", "anticipated", "", opts, callOpts, &res)
+	return res, p, err
+}
+
+// BoxOffice returns last weekend's top 10 grossing movies in the US.
+// Trakt only has a box office for movies, and it isn't paginated.
+func (t *TraktTV) BoxOffice(callOpts ...CallOption) ([]BoxOfficeMovie, error) {
+	return t.BoxOfficeContext(context.Background(), callOpts...)
+}
+
+// BoxOfficeContext is BoxOffice with a context that can cancel the request
+func (t *TraktTV) BoxOfficeContext(ctx context.Context, callOpts ...CallOption) ([]BoxOfficeMovie, error) {
+	res := []BoxOfficeMovie{}
+	_, err := t.discover(ctx, "movies", "boxoffice", "", PageOptions{}, callOpts, &res)
+	return res, err
+}
+
+// discover gets one of the movie or show feeds.  Period is only used by
+// the played, watched and collected feeds, which default to weekly.
+func (t *TraktTV) discover(ctx context.Context, kind, feed string, period Period, opts PageOptions, callOpts []CallOption, result interface{}) (*Pagination, error) {
+	args := map[string]string{
+		"Kind":   kind,
+		"Feed":   feed,
+		"Period": string(period),
+	}
+	params := url.Values{"extended": {extendedArg(callOpts)}}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(DiscoverTmpl, args, params)
+	if err != nil {
+		return nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, result)
+	return parsePagination(h), err
+}
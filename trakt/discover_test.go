@@ -0,0 +1,79 @@
+package trakt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrendingMovies(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/movies/trending" || r.URL.RawQuery != "extended=full&limit=1&page=2" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				w.Header().Set("X-Pagination-Page", "2")
+				w.Header().Set("X-Pagination-Limit", "1")
+				w.Header().Set("X-Pagination-Page-Count", "5")
+				w.Header().Set("X-Pagination-Item-Count", "5")
+				fmt.Fprintln(w, `[{"watchers":21,"movie":{"title":"TRON: Legacy","year":2010,"ids":{"trakt":1}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, p, err := trakt.TrendingMovies(PageOptions{Page: 2, Limit: 1}, ExtendedInfo(ExtendedFull))
+	if err != nil {
+		t.Fatalf("Error getting trending movies: %s", err)
+	}
+	if len(res) != 1 || res[0].Watchers != 21 || res[0].Movie.Title != "TRON: Legacy" {
+		t.Fatalf("Unexpected trending movies: %#v", res)
+	}
+	if p == nil || p.PageCount != 5 {
+		t.Fatalf("Unexpected pagination: %#v", p)
+	}
+}
+
+func TestMostWatchedShows(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/shows/watched/monthly" || r.URL.RawQuery != "limit=10" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"watcher_count":8784,"play_count":40287,"collected_count":6932,"collector_count":1112,"show":{"title":"The Walking Dead","year":2010,"ids":{"trakt":2}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, _, err := trakt.MostWatchedShows(PeriodMonthly, PageOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("Error getting most watched shows: %s", err)
+	}
+	if len(res) != 1 || res[0].WatcherCount != 8784 || res[0].PlayCount != 40287 ||
+		res[0].CollectorCount != 1112 || res[0].Show.Title != "The Walking Dead" {
+		t.Fatalf("Unexpected most watched shows: %#v", res)
+	}
+}
+
+func TestBoxOffice(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/movies/boxoffice" || r.URL.RawQuery != "" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"revenue":48464322,"movie":{"title":"Frozen","year":2013,"ids":{"trakt":3}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, err := trakt.BoxOffice()
+	if err != nil {
+		t.Fatalf("Error getting box office: %s", err)
+	}
+	if len(res) != 1 || res[0].Revenue != 48464322 || res[0].Movie.Title != "Frozen" {
+		t.Fatalf("Unexpected box office: %#v", res)
+	}
+}
@@ -0,0 +1,136 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"text/template"
+)
+
+// https://trakt.docs.apiary.io/#reference/episodes/summary
+var EpisodeSummaryTmpl = template.Must(
+	template.New("EpisodeSummary").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season}}/episodes/{{.Episode}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/episodes/stats
+var EpisodeStatsTmpl = template.Must(
+	template.New("EpisodeStats").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season}}/episodes/{{.Episode}}/stats"),
+)
+
+// https://trakt.docs.apiary.io/#reference/episodes/ratings
+var EpisodeRatingsTmpl = template.Must(
+	template.New("EpisodeRatings").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season}}/episodes/{{.Episode}}/ratings"),
+)
+
+// https://trakt.docs.apiary.io/#reference/episodes/people
+var EpisodePeopleTmpl = template.Must(
+	template.New("EpisodePeople").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season}}/episodes/{{.Episode}}/people"),
+)
+
+// https://trakt.docs.apiary.io/#reference/episodes/watching
+var EpisodeWatchingTmpl = template.Must(
+	template.New("EpisodeWatching").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season}}/episodes/{{.Episode}}/watching"),
+)
+
+// Stats are the counts of what Trakt users have done with an item
+type Stats struct {
+	Watchers   int `json:"watchers"`
+	Plays      int `json:"plays"`
+	Collectors int `json:"collectors"`
+	Comments   int `json:"comments"`
+	Lists      int `json:"lists"`
+	Votes      int `json:"votes"`
+}
+
+// episodeArgs are the template args for an episode
+func episodeArgs(slugOrID string, season, episode int) map[string]string {
+	return map[string]string{
+		"Query":   slugOrID,
+		"Season":  strconv.Itoa(season),
+		"Episode": strconv.Itoa(episode),
+	}
+}
+
+// GetEpisode returns an episode of a show given the show's slug or id.  The
+// details are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetEpisode(slugOrID string, season, episode int, opts ...CallOption) (*Episode, error) {
+	return t.GetEpisodeContext(context.Background(), slugOrID, season, episode, opts...)
+}
+
+// GetEpisodeContext is GetEpisode with a context that can cancel the
+// request
+func (t *TraktTV) GetEpisodeContext(ctx context.Context, slugOrID string, season, episode int, opts ...CallOption) (*Episode, error) {
+	res := &Episode{}
+	args := episodeArgs(slugOrID, season, episode)
+	apiURL, err := t.getURLWithQuery(EpisodeSummaryTmpl, args, url.Values{"extended": {extendedArg(opts, ExtendedFull)}})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
+
+// GetEpisodeStats returns the watcher, play, collector, comment, list and
+// vote counts of an episode
+func (t *TraktTV) GetEpisodeStats(slugOrID string, season, episode int) (*Stats, error) {
+	return t.GetEpisodeStatsContext(context.Background(), slugOrID, season, episode)
+}
+
+// GetEpisodeStatsContext is GetEpisodeStats with a context that can cancel
+// the request
+func (t *TraktTV) GetEpisodeStatsContext(ctx context.Context, slugOrID string, season, episode int) (*Stats, error) {
+	res := &Stats{}
+	apiURL, err := t.getURLFromTemplate(EpisodeStatsTmpl, episodeArgs(slugOrID, season, episode))
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
+
+// GetEpisodeRatings returns the community rating of an episode
+func (t *TraktTV) GetEpisodeRatings(slugOrID string, season, episode int) (*Ratings, error) {
+	return t.GetEpisodeRatingsContext(context.Background(), slugOrID, season, episode)
+}
+
+// GetEpisodeRatingsContext is GetEpisodeRatings with a context that can
+// cancel the request
+func (t *TraktTV) GetEpisodeRatingsContext(ctx context.Context, slugOrID string, season, episode int) (*Ratings, error) {
+	return t.ratings(ctx, EpisodeRatingsTmpl, episodeArgs(slugOrID, season, episode))
+}
+
+// GetEpisodePeople returns the cast, guest stars and crew of an episode
+func (t *TraktTV) GetEpisodePeople(slugOrID string, season, episode int, opts ...CallOption) (*Credits, error) {
+	return t.GetEpisodePeopleContext(context.Background(), slugOrID, season, episode, opts...)
+}
+
+// GetEpisodePeopleContext is GetEpisodePeople with a context that can
+// cancel the request
+func (t *TraktTV) GetEpisodePeopleContext(ctx context.Context, slugOrID string, season, episode int, opts ...CallOption) (*Credits, error) {
+	res := &Credits{}
+	args := episodeArgs(slugOrID, season, episode)
+	apiURL, err := t.getURLWithQuery(EpisodePeopleTmpl, args, url.Values{"extended": {extendedArg(opts)}})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
+
+// GetEpisodeWatching returns the users watching an episode right now
+func (t *TraktTV) GetEpisodeWatching(slugOrID string, season, episode int, opts ...CallOption) ([]User, error) {
+	return t.GetEpisodeWatchingContext(context.Background(), slugOrID, season, episode, opts...)
+}
+
+// GetEpisodeWatchingContext is GetEpisodeWatching with a context that can
+// cancel the request
+func (t *TraktTV) GetEpisodeWatchingContext(ctx context.Context, slugOrID string, season, episode int, opts ...CallOption) ([]User, error) {
+	res := []User{}
+	args := episodeArgs(slugOrID, season, episode)
+	apiURL, err := t.getURLWithQuery(EpisodeWatchingTmpl, args, url.Values{"extended": {extendedArg(opts)}})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, &res)
+	return res, err
+}
@@ -0,0 +1,71 @@
+package trakt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetEpisode(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/shows/game-of-thrones/seasons/1/episodes/1":
+					if r.URL.Query().Get("extended") != "full" {
+						t.Errorf("Unexpected request: %s", r.URL)
+					}
+					fmt.Fprintln(w, `{"season":1,"number":1,"title":"Winter Is Coming","ids":{"trakt":73640},"runtime":62}`)
+				case "/shows/game-of-thrones/seasons/1/episodes/1/stats":
+					fmt.Fprintln(w, `{"watchers":30521,"plays":37986,"collectors":12899,"comments":115,"lists":309,"votes":25655}`)
+				case "/shows/game-of-thrones/seasons/1/episodes/1/ratings":
+					fmt.Fprintln(w, `{"rating":9.0,"votes":3,"distribution":{"10":2,"7":1}}`)
+				case "/shows/game-of-thrones/seasons/1/episodes/1/people":
+					fmt.Fprintln(w, `{"cast":[{"characters":["Eddard Stark"],"person":{"name":"Sean Bean","ids":{"trakt":1}}}],"guest_stars":[{"characters":["Will"],"person":{"name":"Bronson Webb","ids":{"trakt":2}}}],"crew":{"directing":[{"jobs":["Director"],"person":{"name":"Tim Van Patten","ids":{"trakt":3}}}]}}`)
+				case "/shows/game-of-thrones/seasons/1/episodes/1/watching":
+					fmt.Fprintln(w, `[{"username":"justin","private":false,"name":"Justin Nemeth","vip":true,"ids":{"slug":"justin"}}]`)
+				default:
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	ep, err := trakt.GetEpisode("game-of-thrones", 1, 1)
+	if err != nil {
+		t.Fatalf("Error getting episode: %s", err)
+	}
+	if ep.Title != "Winter Is Coming" || ep.Runtime != 62 {
+		t.Fatalf("Unexpected episode: %#v", ep)
+	}
+	stats, err := trakt.GetEpisodeStats("game-of-thrones", 1, 1)
+	if err != nil {
+		t.Fatalf("Error getting episode stats: %s", err)
+	}
+	if stats.Watchers != 30521 || stats.Plays != 37986 || stats.Collectors != 12899 || stats.Comments != 115 {
+		t.Fatalf("Unexpected episode stats: %#v", stats)
+	}
+	ratings, err := trakt.GetEpisodeRatings("game-of-thrones", 1, 1)
+	if err != nil {
+		t.Fatalf("Error getting episode ratings: %s", err)
+	}
+	if ratings.Votes != 3 || ratings.Distribution[10] != 2 {
+		t.Fatalf("Unexpected episode ratings: %#v", ratings)
+	}
+	people, err := trakt.GetEpisodePeople("game-of-thrones", 1, 1)
+	if err != nil {
+		t.Fatalf("Error getting episode people: %s", err)
+	}
+	if len(people.GuestStars) != 1 || people.GuestStars[0].Person.Name != "Bronson Webb" ||
+		len(people.Crew[DepartmentDirecting]) != 1 {
+		t.Fatalf("Unexpected episode people: %#v", people)
+	}
+	users, err := trakt.GetEpisodeWatching("game-of-thrones", 1, 1)
+	if err != nil {
+		t.Fatalf("Error getting episode watchers: %s", err)
+	}
+	if len(users) != 1 || users[0].Username != "justin" || !users[0].VIP {
+		t.Fatalf("Unexpected episode watchers: %#v", users)
+	}
+}
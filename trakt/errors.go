@@ -0,0 +1,136 @@
+package trakt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors that the errors returned by TraktTV methods can be
+// compared against with errors.Is.
+var (
+	// ErrNotFound means the requested item doesn't exist (404)
+	ErrNotFound = errors.New("trakt: not found")
+	// ErrUnauthorized means the OAuth token is missing or invalid (401), or
+	// the API key is invalid or the application unapproved (403)
+	ErrUnauthorized = errors.New("trakt: unauthorized")
+	// ErrRateLimited means Trakt kept answering 429 Too Many Requests
+	ErrRateLimited = errors.New("trakt: rate limited")
+	// ErrConflict means the item was already scrobbled or checked into
+	// recently (409)
+	ErrConflict = errors.New("trakt: conflict")
+	// ErrNotModified means Trakt answered 304 Not Modified to a request
+	// there was no cached copy to fall back on for
+	ErrNotModified = errors.New("trakt: not modified")
+	// ErrNotAuthenticated means a method needing a user's token was called
+	// on a client without one
+	ErrNotAuthenticated = errors.New("trakt: not authenticated")
+	// ErrNoSeasons is returned by ShowSeasons when no seasons are given
+	ErrNoSeasons = errors.New("trakt: must specify which seasons to get")
+	// ErrInvalidRating is returned when a rating isn't from 1 to 10
+	ErrInvalidRating = errors.New("trakt: ratings must be from 1 to 10")
+)
+
+// maxBodyExcerpt is how much of an error response body HTTPError keeps
+const maxBodyExcerpt = 512
+
+// HTTPError is returned when Trakt answers with an error status code.  Use
+// errors.Is with ErrNotFound, ErrUnauthorized, ErrRateLimited or
+// ErrConflict to check for the common cases.
+type HTTPError struct {
+	Method     string `json:"-"`
+	URL        string `json:"-"`
+	StatusCode int    `json:"-"`
+	// Body is the start of the response body
+	Body string `json:"-"`
+	// ErrorDesc and Description are filled from the OAuth style error
+	// body Trakt sends for some failures.
+	ErrorDesc   string `json:"error"`
+	Description string `json:"error_description"`
+	// RetryAfter is how long Trakt asked us to wait, for 429 responses
+	RetryAfter time.Duration `json:"-"`
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("trakt: %s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.ErrorDesc != "" {
+		msg += ": " + e.ErrorDesc
+	}
+	if e.Description != "" {
+		msg += " (" + e.Description + ")"
+	}
+	return msg
+}
+
+// Is makes errors.Is match the sentinel error for the status code
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrNotModified:
+		return e.StatusCode == http.StatusNotModified
+	}
+	return false
+}
+
+// newHTTPError builds an HTTPError from an error response
+func newHTTPError(method, url string, resp *http.Response, data []byte) *HTTPError {
+	e := &HTTPError{
+		Method:     method,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+	}
+	excerpt := data
+	if len(excerpt) > maxBodyExcerpt {
+		excerpt = excerpt[:maxBodyExcerpt]
+	}
+	e.Body = string(bytes.TrimSpace(excerpt))
+	// Not every error has a JSON body, so a failure here isn't an error
+	json.Unmarshal(data, e)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		e.RetryAfter = retryAfter(resp.Header, time.Now())
+	}
+	return e
+}
+
+// DecodeError is returned when a response can't be decoded.  Line, Column
+// and Highlight point at where in the body decoding failed.
+type DecodeError struct {
+	URL       string
+	Offset    int64
+	Line      int
+	Column    int
+	Highlight string
+	Err       error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("trakt: error decoding response from %s at line %d, column %d (file offset %d): %s\n%s",
+		e.URL, e.Line, e.Column, e.Offset, e.Err, e.Highlight)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// newDecodeError wraps a json error with the position it happened at
+func newDecodeError(url string, data []byte, offset int64, err error) *DecodeError {
+	line, col, highlight := highlightBytePosition(bytes.NewReader(data), offset)
+	return &DecodeError{
+		URL:       url,
+		Offset:    offset,
+		Line:      line,
+		Column:    col,
+		Highlight: highlight,
+		Err:       err,
+	}
+}
@@ -0,0 +1,71 @@
+package trakt
+
+import (
+	"strings"
+)
+
+// Extended is a level of detail Trakt can include in responses
+type Extended string
+
+// Extended info levels.  ExtendedMin is the bare minimum Trakt sends when
+// no level is asked for: titles, years and IDs.
+const (
+	ExtendedMin      Extended = "min"
+	ExtendedFull     Extended = "full"
+	ExtendedImages   Extended = "images"
+	ExtendedEpisodes Extended = "episodes"
+	ExtendedMetadata Extended = "metadata"
+)
+
+// CallOption changes how a single call is made
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	extended    []Extended
+	extendedSet bool
+}
+
+// ExtendedInfo sets the levels of detail to ask for, replacing the call's
+// default.  ExtendedInfo(ExtendedMin) gets the smallest responses,
+// ExtendedInfo(ExtendedFull, ExtendedImages) fills in everything
+// including Images.
+func ExtendedInfo(levels ...Extended) CallOption {
+	return func(o *callOptions) {
+		o.extended = levels
+		o.extendedSet = true
+	}
+}
+
+// extendedArg works out the extended parameter for a call, using the
+// defaults unless ExtendedInfo was given.  An empty result means the
+// parameter should be left out.
+func extendedArg(opts []CallOption, defaults ...Extended) string {
+	o := &callOptions{extended: defaults}
+	for _, opt := range opts {
+		opt(o)
+	}
+	levels := []string{}
+	seen := map[Extended]bool{}
+	for _, l := range o.extended {
+		if l == ExtendedMin || l == "" || seen[l] {
+			continue
+		}
+		seen[l] = true
+		levels = append(levels, string(l))
+	}
+	return strings.Join(levels, ",")
+}
+
+// withEpisodes adds ExtendedEpisodes to the levels for endpoints that
+// need it to fill in what's asked for.
+func withEpisodes(extended string) string {
+	for _, l := range strings.Split(extended, ",") {
+		if l == string(ExtendedEpisodes) {
+			return extended
+		}
+	}
+	if extended == "" {
+		return string(ExtendedEpisodes)
+	}
+	return extended + "," + string(ExtendedEpisodes)
+}
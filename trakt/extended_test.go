@@ -0,0 +1,115 @@
+package trakt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtendedInfoDefaults(t *testing.T) {
+	extended := map[string]string{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				extended[r.URL.Path] = r.URL.RawQuery
+				if r.URL.Path == "/shows/battlestar-galactica-2003" || r.URL.Path == "/movies/batman-1989" {
+					fmt.Fprintln(w, "{}")
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	trakt.GetShow("battlestar-galactica-2003")
+	trakt.GetMovie("batman-1989")
+	trakt.ShowSeasons("battlestar-galactica-2003", []int{1})
+	trakt.MovieSearch("batman")
+
+	want := map[string]string{
+		"/shows/battlestar-galactica-2003":           "extended=full",
+		"/shows/battlestar-galactica-2003/seasons":   "extended=full,episodes",
+		"/shows/battlestar-galactica-2003/seasons/1": "extended=full",
+		"/movies/batman-1989":                        "extended=full",
+		"/search/movie":                              "query=batman",
+	}
+	for path, query := range want {
+		if extended[path] != query {
+			t.Fatalf("Expected %s to be fetched with %q, got %q", path, query, extended[path])
+		}
+	}
+}
+
+func TestExtendedInfoOption(t *testing.T) {
+	extended := map[string]string{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				extended[r.URL.Path] = r.URL.Query().Get("extended")
+				switch r.URL.Path {
+				case "/movies/batman-1989":
+					fmt.Fprintln(w, `{"title":"Batman","year":1989,"ids":{"trakt":224},"images":{"poster":["walter-r2.trakt.tv/images/movies/000/000/224/posters/medium/1.jpg"],"fanart":[]}}`)
+				case "/shows/battlestar-galactica-2003":
+					fmt.Fprintln(w, `{"title":"Battlestar Galactica","year":2003}`)
+				default:
+					fmt.Fprintln(w, "[]")
+				}
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	m, err := trakt.GetMovie("batman-1989", ExtendedInfo(ExtendedFull, ExtendedImages))
+	if err != nil {
+		t.Fatalf("Error getting movie: %s", err)
+	}
+	if m.Images == nil || len(m.Images.Poster) != 1 {
+		t.Fatalf("Expected images to be filled in, got %#v", m.Images)
+	}
+	trakt.GetShow("battlestar-galactica-2003", ExtendedInfo(ExtendedMin))
+	trakt.Search("battlestar", SearchOptions{Types: []string{SearchShow}}, ExtendedInfo(ExtendedFull))
+
+	want := map[string]string{
+		"/movies/batman-1989":                      "full,images",
+		"/shows/battlestar-galactica-2003":         "",
+		"/shows/battlestar-galactica-2003/seasons": "episodes",
+		"/search/show":                             "full",
+	}
+	for path, level := range want {
+		if extended[path] != level {
+			t.Fatalf("Expected %s to be fetched with extended=%q, got %q", path, level, extended[path])
+		}
+	}
+}
+
+func TestExtendedInfoUserLists(t *testing.T) {
+	queries := map[string]string{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				queries[r.URL.Path] = r.URL.RawQuery
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	trakt.GetHistory(HistoryOptions{Page: 2}, ExtendedInfo(ExtendedMin))
+	trakt.GetWatchlist(WatchlistOptions{})
+	trakt.GetRatings(RatingsOptions{Limit: 5}, ExtendedInfo(ExtendedFull, ExtendedImages))
+	p := trakt.PaginateListItems(context.Background(), "sean", "favorites", ListItemsOptions{Limit: 10}, ExtendedInfo(ExtendedImages))
+	for p.Next() {
+	}
+
+	want := map[string]string{
+		"/sync/history":                     "page=2",
+		"/sync/watchlist":                   "extended=full",
+		"/sync/ratings":                     "extended=full,images&limit=5",
+		"/users/sean/lists/favorites/items": "extended=images&limit=10&page=1",
+	}
+	for path, query := range want {
+		if queries[path] != query {
+			t.Fatalf("Expected %s to be fetched with %q, got %q", path, query, queries[path])
+		}
+	}
+}
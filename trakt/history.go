@@ -0,0 +1,113 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/get-history
+var HistoryTmpl = template.Must(
+	template.New("History").Parse("{{.Host}}/sync/history{{if .Type}}/{{.Type | urlquery}}{{if .ID}}/{{.ID | urlquery}}{{end}}{{end}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/add-to-history
+var AddToHistoryTmpl = template.Must(
+	template.New("AddToHistory").Parse("{{.Host}}/sync/history"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/remove-from-history
+var RemoveFromHistoryTmpl = template.Must(
+	template.New("RemoveFromHistory").Parse("{{.Host}}/sync/history/remove"),
+)
+
+// HistoryItem is one watch of a movie or episode in a user's history
+type HistoryItem struct {
+	ID        int64     `json:"id"`
+	WatchedAt time.Time `json:"watched_at"`
+	// Action is scrobble, checkin or watch
+	Action  string   `json:"action"`
+	Type    string   `json:"type"`
+	Movie   *Movie   `json:"movie"`
+	Show    *Show    `json:"show"`
+	Episode *Episode `json:"episode"`
+}
+
+// HistoryOptions filters and pages the results of GetHistory.  Zero values
+// are left out of the request.
+type HistoryOptions struct {
+	// Type is movies, shows, seasons or episodes
+	Type string
+	// ID limits the history to one item of Type, by its Trakt id
+	ID      string
+	StartAt time.Time
+	EndAt   time.Time
+	Page    int
+	Limit   int
+}
+
+// GetHistory returns the authenticated user's watch history, most recent
+// first, along with the pagination Trakt returned.  The items are
+// ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetHistory(opts HistoryOptions, callOpts ...CallOption) ([]HistoryItem, *Pagination, error) {
+	return t.GetHistoryContext(context.Background(), opts, callOpts...)
+}
+
+// GetHistoryContext is GetHistory with a context that can cancel the
+// request
+func (t *TraktTV) GetHistoryContext(ctx context.Context, opts HistoryOptions, callOpts ...CallOption) ([]HistoryItem, *Pagination, error) {
+	args := map[string]string{
+		"Type": opts.Type,
+		"ID":   opts.ID,
+	}
+	params := url.Values{"extended": {extendedArg(callOpts, ExtendedFull)}}
+	pageParams(params, opts.Page, opts.Limit)
+	if !opts.StartAt.IsZero() {
+		params.Set("start_at", opts.StartAt.UTC().Format(time.RFC3339))
+	}
+	if !opts.EndAt.IsZero() {
+		params.Set("end_at", opts.EndAt.UTC().Format(time.RFC3339))
+	}
+	res := []HistoryItem{}
+	apiURL, err := t.getURLWithQuery(HistoryTmpl, args, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getAuthenticated(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// AddToHistory marks the items as watched, at their WatchedAt time or now
+func (t *TraktTV) AddToHistory(items *SyncItems) (*SyncResult, error) {
+	return t.AddToHistoryContext(context.Background(), items)
+}
+
+// AddToHistoryContext is AddToHistory with a context that can cancel the
+// request
+func (t *TraktTV) AddToHistoryContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, AddToHistoryTmpl, items)
+}
+
+// RemoveFromHistory removes every watch of the items, or the individual
+// watches listed in items.IDs, from the user's history.
+func (t *TraktTV) RemoveFromHistory(items *SyncItems) (*SyncResult, error) {
+	return t.RemoveFromHistoryContext(context.Background(), items)
+}
+
+// RemoveFromHistoryContext is RemoveFromHistory with a context that can
+// cancel the request
+func (t *TraktTV) RemoveFromHistoryContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, RemoveFromHistoryTmpl, items)
+}
+
+// sync POSTs items to one of the sync endpoints
+func (t *TraktTV) sync(ctx context.Context, tmpl *template.Template, items *SyncItems) (*SyncResult, error) {
+	res := &SyncResult{}
+	apiURL, err := t.getURLFromTemplate(tmpl, map[string]string{})
+	if err != nil {
+		return res, err
+	}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, items, res)
+	return res, err
+}
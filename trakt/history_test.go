@@ -0,0 +1,118 @@
+package trakt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetHistory(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer access1" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.URL.Path != "/sync/history/movies" || r.URL.Query().Get("page") != "2" ||
+					r.URL.Query().Get("start_at") != "2016-06-01T00:00:00Z" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				w.Header().Set("X-Pagination-Page", "2")
+				w.Header().Set("X-Pagination-Limit", "10")
+				w.Header().Set("X-Pagination-Page-Count", "3")
+				w.Header().Set("X-Pagination-Item-Count", "25")
+				fmt.Fprintln(w, `[{"id":1982346,"watched_at":"2016-06-15T21:06:42.000Z","action":"scrobble","type":"movie","movie":{"title":"Batman","year":1989,"ids":{"trakt":224,"slug":"batman-1989"}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	items, page, err := trakt.GetHistory(HistoryOptions{
+		Type:    "movies",
+		Page:    2,
+		StartAt: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Error getting history: %s", err)
+	}
+	if len(items) != 1 || items[0].Movie == nil || items[0].Movie.Title != "Batman" || items[0].Action != "scrobble" {
+		t.Fatalf("Unexpected history: %#v", items)
+	}
+	if page == nil || page.Page != 2 || page.PageCount != 3 || page.ItemCount != 25 {
+		t.Fatalf("Unexpected pagination: %#v", page)
+	}
+}
+
+func TestAddToHistory(t *testing.T) {
+	var posted map[string]interface{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/sync/history" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"added":{"movies":1,"episodes":2},"not_found":{"movies":[{"ids":{"imdb":"tt0000111"}}],"shows":[],"seasons":[],"episodes":[],"people":[]}}`)
+			}))
+	defer ts.Close()
+
+	watched := time.Date(2016, 6, 15, 21, 0, 0, 0, time.UTC)
+	items := &SyncItems{}
+	items.AddMovie(&Movie{IDs: IDs{Trakt: 224}}, watched).
+		AddMovie(&Movie{IDs: IDs{Imdb: "tt0000111"}}, time.Time{}).
+		AddShowEpisode(IDs{Tvdb: 73545}, 1, 1, watched).
+		AddShowEpisode(IDs{Tvdb: 73545}, 1, 2, watched)
+
+	trakt := authedClient(ts.URL)
+	res, err := trakt.AddToHistory(items)
+	if err != nil {
+		t.Fatalf("Error adding to history: %s", err)
+	}
+	if res.Added.Movies != 1 || res.Added.Episodes != 2 {
+		t.Fatalf("Unexpected counts: %#v", res.Added)
+	}
+	if len(res.NotFound.Movies) != 1 || res.NotFound.Movies[0].IDs.Imdb != "tt0000111" {
+		t.Fatalf("Unexpected not found: %#v", res.NotFound)
+	}
+
+	movies := posted["movies"].([]interface{})
+	if len(movies) != 2 || movies[0].(map[string]interface{})["watched_at"] != "2016-06-15T21:00:00Z" {
+		t.Fatalf("Unexpected movies posted: %#v", posted["movies"])
+	}
+	if _, ok := movies[1].(map[string]interface{})["watched_at"]; ok {
+		t.Fatalf("Expected a zero watched time to be left out: %#v", movies[1])
+	}
+	shows := posted["shows"].([]interface{})
+	seasons := shows[0].(map[string]interface{})["seasons"].([]interface{})
+	if len(shows) != 1 || len(seasons) != 1 || len(seasons[0].(map[string]interface{})["episodes"].([]interface{})) != 2 {
+		t.Fatalf("Expected both episodes under one show and season: %#v", posted["shows"])
+	}
+}
+
+func TestHistoryNeedsAuthentication(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	_, _, err := trakt.GetHistory(HistoryOptions{})
+	if !errors.Is(err, ErrNotAuthenticated) {
+		t.Fatalf("Expected ErrNotAuthenticated, got %#v", err)
+	}
+	_, err = trakt.RemoveFromHistory(&SyncItems{IDs: []int64{1982346}})
+	if !errors.Is(err, ErrNotAuthenticated) {
+		t.Fatalf("Expected ErrNotAuthenticated, got %#v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("Expected no requests without a token, got %d", calls)
+	}
+}
@@ -0,0 +1,381 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/users/lists
+var ListsTmpl = template.Must(
+	template.New("Lists").Parse("{{.Host}}/users/{{.User | urlquery}}/lists"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/list
+var ListTmpl = template.Must(
+	template.New("List").Parse("{{.Host}}/users/{{.User | urlquery}}/lists/{{.List | urlquery}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/list-items
+var ListItemsTmpl = template.Must(
+	template.New("ListItems").Parse("{{.Host}}/users/{{.User | urlquery}}/lists/{{.List | urlquery}}/items{{if .Type}}/{{.Type | urlquery}}{{end}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/add-list-items
+var AddListItemsTmpl = template.Must(
+	template.New("AddListItems").Parse("{{.Host}}/users/me/lists/{{.List | urlquery}}/items"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/remove-list-items
+var RemoveListItemsTmpl = template.Must(
+	template.New("RemoveListItems").Parse("{{.Host}}/users/me/lists/{{.List | urlquery}}/items/remove"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/reorder-list-items
+var ReorderListItemsTmpl = template.Must(
+	template.New("ReorderListItems").Parse("{{.Host}}/users/me/lists/{{.List | urlquery}}/items/reorder"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/list-likes
+var ListLikesTmpl = template.Must(
+	template.New("ListLikes").Parse("{{.Host}}/users/{{.User | urlquery}}/lists/{{.List | urlquery}}/likes"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/list-like
+var ListLikeTmpl = template.Must(
+	template.New("ListLike").Parse("{{.Host}}/users/{{.User | urlquery}}/lists/{{.List | urlquery}}/like"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/list-comments
+var ListCommentsTmpl = template.Must(
+	template.New("ListComments").Parse("{{.Host}}/users/{{.User | urlquery}}/lists/{{.List | urlquery}}/comments{{if .Sort}}/{{.Sort | urlquery}}{{end}}"),
+)
+
+// Who can see a list
+const (
+	PrivacyPrivate = "private"
+	PrivacyLink    = "link"
+	PrivacyFriends = "friends"
+	PrivacyPublic  = "public"
+)
+
+// List is a custom list of movies, shows, seasons, episodes and people
+type List struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Privacy is one of the Privacy constants
+	Privacy        string `json:"privacy"`
+	DisplayNumbers bool   `json:"display_numbers"`
+	AllowComments  bool   `json:"allow_comments"`
+	// SortBy and SortHow are how the list is shown on the website, i.e.
+	// "rank" and "asc"
+	SortBy       string    `json:"sort_by"`
+	SortHow      string    `json:"sort_how"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ItemCount    int       `json:"item_count"`
+	CommentCount int       `json:"comment_count"`
+	Likes        int       `json:"likes"`
+	IDs          IDs       `json:"ids"`
+	User         *User     `json:"user"`
+}
+
+// ListParams are the settings to create or update a list with.  Zero
+// values are left out, so updating a list only changes what's set.
+type ListParams struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Privacy        string `json:"privacy,omitempty"`
+	DisplayNumbers *bool  `json:"display_numbers,omitempty"`
+	AllowComments  *bool  `json:"allow_comments,omitempty"`
+	SortBy         string `json:"sort_by,omitempty"`
+	SortHow        string `json:"sort_how,omitempty"`
+}
+
+// ListItem is an entry on a custom list
+type ListItem struct {
+	// ID identifies the entry, for ReorderListItems
+	ID       int64     `json:"id"`
+	Rank     int       `json:"rank"`
+	ListedAt time.Time `json:"listed_at"`
+	Notes    string    `json:"notes"`
+	// Type is movie, show, season, episode or person
+	Type    string   `json:"type"`
+	Movie   *Movie   `json:"movie"`
+	Show    *Show    `json:"show"`
+	Season  *Season  `json:"season"`
+	Episode *Episode `json:"episode"`
+	Person  *Person  `json:"person"`
+}
+
+// ListItemsOptions filters and pages the results of GetListItems.  Zero
+// values are left out of the request.
+type ListItemsOptions struct {
+	// Type is movies, shows, seasons, episodes or people, or a comma
+	// separated combination of them
+	Type  string
+	Page  int
+	Limit int
+}
+
+// ListItemsResult is Trakt's answer to adding or removing list items
+type ListItemsResult struct {
+	SyncResult
+	List struct {
+		UpdatedAt time.Time `json:"updated_at"`
+		ItemCount int       `json:"item_count"`
+	} `json:"list"`
+}
+
+// Like is a user liking something
+type Like struct {
+	LikedAt time.Time `json:"liked_at"`
+	User    User      `json:"user"`
+}
+
+// PageOptions pages the results of endpoints that only take a page and
+// limit.  Zero values are left out of the request.
+type PageOptions struct {
+	Page  int
+	Limit int
+}
+
+// CommentsOptions sorts and pages comments.  Zero values are left out of
+// the request.
+type CommentsOptions struct {
+	// Sort is newest, oldest, likes or replies
+	Sort  string
+	Page  int
+	Limit int
+}
+
+// GetLists returns a user's custom lists.  Use "me" for the authenticated
+// user.
+func (t *TraktTV) GetLists(user string) ([]List, error) {
+	return t.GetListsContext(context.Background(), user)
+}
+
+// GetListsContext is GetLists with a context that can cancel the request
+func (t *TraktTV) GetListsContext(ctx context.Context, user string) ([]List, error) {
+	res := []List{}
+	apiURL, err := t.getURLFromTemplate(ListsTmpl, map[string]string{"User": user})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, &res)
+	return res, err
+}
+
+// GetList returns one of a user's lists by its Trakt id or slug
+func (t *TraktTV) GetList(user, listID string) (*List, error) {
+	return t.GetListContext(context.Background(), user, listID)
+}
+
+// GetListContext is GetList with a context that can cancel the request
+func (t *TraktTV) GetListContext(ctx context.Context, user, listID string) (*List, error) {
+	res := &List{}
+	apiURL, err := t.getURLFromTemplate(ListTmpl, map[string]string{"User": user, "List": listID})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
+
+// CreateList creates a list for the authenticated user.  Name is required.
+func (t *TraktTV) CreateList(params *ListParams) (*List, error) {
+	return t.CreateListContext(context.Background(), params)
+}
+
+// CreateListContext is CreateList with a context that can cancel the
+// request
+func (t *TraktTV) CreateListContext(ctx context.Context, params *ListParams) (*List, error) {
+	res := &List{}
+	apiURL, err := t.getURLFromTemplate(ListsTmpl, map[string]string{"User": "me"})
+	if err != nil {
+		return res, err
+	}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, params, res)
+	return res, err
+}
+
+// UpdateList changes the settings of one of the authenticated user's lists
+func (t *TraktTV) UpdateList(listID string, params *ListParams) (*List, error) {
+	return t.UpdateListContext(context.Background(), listID, params)
+}
+
+// UpdateListContext is UpdateList with a context that can cancel the
+// request
+func (t *TraktTV) UpdateListContext(ctx context.Context, listID string, params *ListParams) (*List, error) {
+	res := &List{}
+	apiURL, err := t.getURLFromTemplate(ListTmpl, map[string]string{"User": "me", "List": listID})
+	if err != nil {
+		return res, err
+	}
+	err = t.sendAuthenticated(ctx, "PUT", apiURL, params, res)
+	return res, err
+}
+
+// DeleteList deletes one of the authenticated user's lists and its items
+func (t *TraktTV) DeleteList(listID string) error {
+	return t.DeleteListContext(context.Background(), listID)
+}
+
+// DeleteListContext is DeleteList with a context that can cancel the
+// request
+func (t *TraktTV) DeleteListContext(ctx context.Context, listID string) error {
+	apiURL, err := t.getURLFromTemplate(ListTmpl, map[string]string{"User": "me", "List": listID})
+	if err != nil {
+		return err
+	}
+	return t.sendAuthenticated(ctx, "DELETE", apiURL, nil, nil)
+}
+
+// GetListItems returns the items on a list along with the pagination Trakt
+// returned, which is nil unless a page or limit was asked for.  The items
+// are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetListItems(user, listID string, opts ListItemsOptions, callOpts ...CallOption) ([]ListItem, *Pagination, error) {
+	return t.GetListItemsContext(context.Background(), user, listID, opts, callOpts...)
+}
+
+// GetListItemsContext is GetListItems with a context that can cancel the
+// request
+func (t *TraktTV) GetListItemsContext(ctx context.Context, user, listID string, opts ListItemsOptions, callOpts ...CallOption) ([]ListItem, *Pagination, error) {
+	res := []ListItem{}
+	args := map[string]string{
+		"User": user,
+		"List": listID,
+		"Type": opts.Type,
+	}
+	params := url.Values{"extended": {extendedArg(callOpts, ExtendedFull)}}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(ListItemsTmpl, args, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// AddListItems adds the items to one of the authenticated user's lists
+func (t *TraktTV) AddListItems(listID string, items *SyncItems) (*ListItemsResult, error) {
+	return t.AddListItemsContext(context.Background(), listID, items)
+}
+
+// AddListItemsContext is AddListItems with a context that can cancel the
+// request
+func (t *TraktTV) AddListItemsContext(ctx context.Context, listID string, items *SyncItems) (*ListItemsResult, error) {
+	return t.listItems(ctx, AddListItemsTmpl, listID, items)
+}
+
+// RemoveListItems removes the items from one of the authenticated user's
+// lists
+func (t *TraktTV) RemoveListItems(listID string, items *SyncItems) (*ListItemsResult, error) {
+	return t.RemoveListItemsContext(context.Background(), listID, items)
+}
+
+// RemoveListItemsContext is RemoveListItems with a context that can cancel
+// the request
+func (t *TraktTV) RemoveListItemsContext(ctx context.Context, listID string, items *SyncItems) (*ListItemsResult, error) {
+	return t.listItems(ctx, RemoveListItemsTmpl, listID, items)
+}
+
+func (t *TraktTV) listItems(ctx context.Context, tmpl *template.Template, listID string, items *SyncItems) (*ListItemsResult, error) {
+	res := &ListItemsResult{}
+	apiURL, err := t.getURLFromTemplate(tmpl, map[string]string{"List": listID})
+	if err != nil {
+		return res, err
+	}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, items, res)
+	return res, err
+}
+
+// ReorderListItems sets the rank of a list's entries to the order of their
+// ids, as given by ListItem.ID
+func (t *TraktTV) ReorderListItems(listID string, ids []int64) (*ReorderResult, error) {
+	return t.ReorderListItemsContext(context.Background(), listID, ids)
+}
+
+// ReorderListItemsContext is ReorderListItems with a context that can
+// cancel the request
+func (t *TraktTV) ReorderListItemsContext(ctx context.Context, listID string, ids []int64) (*ReorderResult, error) {
+	res := &ReorderResult{}
+	apiURL, err := t.getURLFromTemplate(ReorderListItemsTmpl, map[string]string{"List": listID})
+	if err != nil {
+		return res, err
+	}
+	payload := map[string][]int64{"rank": ids}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, payload, res)
+	return res, err
+}
+
+// GetListLikes returns the users who liked a list along with the
+// pagination Trakt returned
+func (t *TraktTV) GetListLikes(user, listID string, opts PageOptions) ([]Like, *Pagination, error) {
+	return t.GetListLikesContext(context.Background(), user, listID, opts)
+}
+
+// GetListLikesContext is GetListLikes with a context that can cancel the
+// request
+func (t *TraktTV) GetListLikesContext(ctx context.Context, user, listID string, opts PageOptions) ([]Like, *Pagination, error) {
+	res := []Like{}
+	params := url.Values{}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(ListLikesTmpl, map[string]string{"User": user, "List": listID}, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// LikeList likes a list as the authenticated user
+func (t *TraktTV) LikeList(user, listID string) error {
+	return t.LikeListContext(context.Background(), user, listID)
+}
+
+// LikeListContext is LikeList with a context that can cancel the request
+func (t *TraktTV) LikeListContext(ctx context.Context, user, listID string) error {
+	return t.likeList(ctx, "POST", user, listID)
+}
+
+// UnlikeList removes the authenticated user's like of a list
+func (t *TraktTV) UnlikeList(user, listID string) error {
+	return t.UnlikeListContext(context.Background(), user, listID)
+}
+
+// UnlikeListContext is UnlikeList with a context that can cancel the
+// request
+func (t *TraktTV) UnlikeListContext(ctx context.Context, user, listID string) error {
+	return t.likeList(ctx, "DELETE", user, listID)
+}
+
+func (t *TraktTV) likeList(ctx context.Context, method, user, listID string) error {
+	apiURL, err := t.getURLFromTemplate(ListLikeTmpl, map[string]string{"User": user, "List": listID})
+	if err != nil {
+		return err
+	}
+	return t.sendAuthenticated(ctx, method, apiURL, nil, nil)
+}
+
+// GetListComments returns the comments on a list along with the
+// pagination Trakt returned
+func (t *TraktTV) GetListComments(user, listID string, opts CommentsOptions) ([]Comment, *Pagination, error) {
+	return t.GetListCommentsContext(context.Background(), user, listID, opts)
+}
+
+// GetListCommentsContext is GetListComments with a context that can cancel
+// the request
+func (t *TraktTV) GetListCommentsContext(ctx context.Context, user, listID string, opts CommentsOptions) ([]Comment, *Pagination, error) {
+	res := []Comment{}
+	args := map[string]string{"User": user, "List": listID, "Sort": opts.Sort}
+	params := url.Values{}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(ListCommentsTmpl, args, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
@@ -0,0 +1,152 @@
+package trakt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testList = `{"name":"Star Wars in machete order","description":"Next time you want to introduce someone to Star Wars.","privacy":"public","display_numbers":true,"allow_comments":true,"sort_by":"rank","sort_how":"asc","created_at":"2014-10-11T17:00:54.000Z","updated_at":"2014-10-11T17:00:54.000Z","item_count":5,"comment_count":0,"likes":0,"ids":{"trakt":55,"slug":"star-wars-in-machete-order"},"user":{"username":"sean","private":false,"name":"Sean Rudford","vip":true,"ids":{"slug":"sean"}}}`
+
+func TestListCRUD(t *testing.T) {
+	var posted map[string]interface{}
+	methods := []string{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method+" "+r.URL.Path)
+				switch r.Method {
+				case "POST", "PUT":
+					posted = nil
+					json.NewDecoder(r.Body).Decode(&posted)
+					if r.Method == "POST" {
+						w.WriteHeader(http.StatusCreated)
+					}
+					fmt.Fprintln(w, testList)
+				case "DELETE":
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					fmt.Fprintln(w, "["+testList+"]")
+				}
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	l, err := trakt.CreateList(&ListParams{Name: "Star Wars in machete order", Privacy: PrivacyPublic})
+	if err != nil {
+		t.Fatalf("Error creating list: %s", err)
+	}
+	if l.IDs.Trakt != 55 || l.User == nil || l.User.Username != "sean" || !l.DisplayNumbers {
+		t.Fatalf("Unexpected list: %#v", l)
+	}
+	if posted["name"] != "Star Wars in machete order" || posted["privacy"] != "public" || len(posted) != 2 {
+		t.Fatalf("Unexpected body posted: %#v", posted)
+	}
+
+	off := false
+	_, err = trakt.UpdateList("star-wars-in-machete-order", &ListParams{AllowComments: &off})
+	if err != nil {
+		t.Fatalf("Error updating list: %s", err)
+	}
+	if posted["allow_comments"] != false || len(posted) != 1 {
+		t.Fatalf("Expected only the changed setting to be sent, got %#v", posted)
+	}
+
+	lists, err := trakt.GetLists("me")
+	if err != nil || len(lists) != 1 {
+		t.Fatalf("Error getting lists: %s %#v", err, lists)
+	}
+	if err := trakt.DeleteList("55"); err != nil {
+		t.Fatalf("Error deleting list: %s", err)
+	}
+
+	want := []string{
+		"POST /users/me/lists",
+		"PUT /users/me/lists/star-wars-in-machete-order",
+		"GET /users/me/lists",
+		"DELETE /users/me/lists/55",
+	}
+	for i, m := range want {
+		if methods[i] != m {
+			t.Fatalf("Expected request %d to be %s, got %s", i, m, methods[i])
+		}
+	}
+}
+
+func TestListItems(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/users/sean/lists/star-wars-in-machete-order/items/movies,shows":
+					if r.URL.Query().Get("page") != "2" || r.URL.Query().Get("limit") != "1" {
+						t.Errorf("Unexpected paging: %s", r.URL)
+					}
+					w.Header().Set("X-Pagination-Page", "2")
+					w.Header().Set("X-Pagination-Limit", "1")
+					w.Header().Set("X-Pagination-Page-Count", "5")
+					w.Header().Set("X-Pagination-Item-Count", "5")
+					fmt.Fprintln(w, `[{"rank":2,"id":102,"listed_at":"2014-06-16T06:07:12.000Z","type":"movie","movie":{"title":"Star Wars: Episode V - The Empire Strikes Back","year":1980,"ids":{"trakt":2}}}]`)
+				case "/users/me/lists/star-wars-in-machete-order/items":
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprintln(w, `{"added":{"movies":1,"shows":0,"seasons":0,"episodes":0,"people":0},"existing":{"movies":0},"not_found":{"movies":[]},"list":{"updated_at":"2022-04-27T21:40:41.000Z","item_count":6}}`)
+				default:
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	items, page, err := trakt.GetListItems("sean", "star-wars-in-machete-order", ListItemsOptions{Type: "movies,shows", Page: 2, Limit: 1})
+	if err != nil {
+		t.Fatalf("Error getting list items: %s", err)
+	}
+	if len(items) != 1 || items[0].ID != 102 || items[0].Movie.Year != 1980 {
+		t.Fatalf("Unexpected list items: %#v", items)
+	}
+	if page == nil || page.PageCount != 5 {
+		t.Fatalf("Unexpected pagination: %#v", page)
+	}
+
+	res, err := trakt.AddListItems("star-wars-in-machete-order", (&SyncItems{}).AddMovie(&Movie{IDs: IDs{Trakt: 3}}, time.Time{}))
+	if err != nil {
+		t.Fatalf("Error adding list items: %s", err)
+	}
+	if res.Added.Movies != 1 || res.List.ItemCount != 6 {
+		t.Fatalf("Unexpected result: %#v", res)
+	}
+}
+
+func TestListLikesAndComments(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method + " " + r.URL.RequestURI() {
+				case "GET /users/sean/lists/55/likes?limit=10":
+					fmt.Fprintln(w, `[{"liked_at":"2014-09-01T09:10:11.000Z","user":{"username":"sean"}}]`)
+				case "GET /users/sean/lists/55/comments/newest":
+					fmt.Fprintln(w, `[{"id":8,"parent_id":0,"created_at":"2011-03-25T22:35:17.000Z","comment":"Can't wait to watch everything on this epic list!","spoiler":false,"review":false,"replies":0,"likes":0,"user":{"username":"sean"}}]`)
+				case "POST /users/sean/lists/55/like":
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	likes, _, err := trakt.GetListLikes("sean", "55", PageOptions{Limit: 10})
+	if err != nil || len(likes) != 1 || likes[0].User.Username != "sean" {
+		t.Fatalf("Unexpected likes: %#v %s", likes, err)
+	}
+	comments, _, err := trakt.GetListComments("sean", "55", CommentsOptions{Sort: "newest"})
+	if err != nil || len(comments) != 1 || comments[0].ID != 8 {
+		t.Fatalf("Unexpected comments: %#v %s", comments, err)
+	}
+	if err := trakt.LikeList("sean", "55"); err != nil {
+		t.Fatalf("Error liking list: %s", err)
+	}
+}
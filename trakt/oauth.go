@@ -0,0 +1,358 @@
+package trakt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"text/template"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// https://trakt.docs.apiary.io/#reference/authentication-devices/device-code
+var DeviceCodeTmpl = template.Must(
+	template.New("DeviceCode").Parse("{{.Host}}/oauth/device/code"),
+)
+
+// https://trakt.docs.apiary.io/#reference/authentication-devices/get-token
+var DeviceTokenTmpl = template.Must(
+	template.New("DeviceToken").Parse("{{.Host}}/oauth/device/token"),
+)
+
+// https://trakt.docs.apiary.io/#reference/authentication-oauth/get-token
+var TokenTmpl = template.Must(
+	template.New("Token").Parse("{{.Host}}/oauth/token"),
+)
+
+// https://trakt.docs.apiary.io/#reference/authentication-oauth/revoke-token
+var RevokeTokenTmpl = template.Must(
+	template.New("RevokeToken").Parse("{{.Host}}/oauth/revoke"),
+)
+
+// https://trakt.docs.apiary.io/#reference/authentication-oauth/authorize
+var AuthorizeTmpl = template.Must(
+	template.New("Authorize").Parse("{{.AuthHost}}/oauth/authorize"),
+)
+
+// Base URL users are sent to to authorize an application
+const TraktTVAuthURL = "https://trakt.tv"
+
+// OutOfBandRedirectURI is the redirect uri to use for applications that
+// can't receive a redirect and have the user paste the code instead.
+const OutOfBandRedirectURI = "urn:ietf:wg:oauth:2.0:oob"
+
+// tokenRefreshMargin is how long before a token expires it is refreshed
+const tokenRefreshMargin = 5 * time.Minute
+
+// defaultPollInterval is how often PollDeviceToken polls if Trakt didn't
+// say
+var defaultPollInterval = 5 * time.Second
+
+// Errors returned by PollDeviceToken when the user doesn't complete the
+// device authorization.
+var (
+	ErrInvalidDeviceCode = errors.New("trakt: invalid device code")
+	ErrDeviceCodeUsed    = errors.New("trakt: device code has already been used")
+	ErrDeviceCodeExpired = errors.New("trakt: device code expired before the user authorized it")
+	ErrAccessDenied      = errors.New("trakt: user denied the authorization request")
+)
+
+// Token is an OAuth2 token issued by Trakt.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// Expiry returns the time at which the access token stops being valid
+func (tok *Token) Expiry() time.Time {
+	return time.Unix(tok.CreatedAt+tok.ExpiresIn, 0)
+}
+
+// expiresWithin reports if the access token expires within the given
+// duration from now.
+func (tok *Token) expiresWithin(d time.Duration) bool {
+	return time.Now().Add(d).After(tok.Expiry())
+}
+
+// DeviceCode is returned when starting the device authentication flow.
+// Show the user VerificationURL and UserCode and then call PollDeviceToken.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURL string `json:"verification_url"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// OAuth configures the client secret, redirect uri and token store used to
+// authenticate as a user.  Requests are sent with the token held by the
+// store, which is refreshed automatically shortly before it expires.
+func OAuth(clientSecret, redirectURI string, store TokenStore) option {
+	return func(t *TraktTV) {
+		t.ClientSecret = clientSecret
+		t.RedirectURI = redirectURI
+		t.Tokens = store
+	}
+}
+
+// AuthHost sets the host users are sent to to authorize the application
+func AuthHost(host string) option {
+	return func(t *TraktTV) {
+		t.AuthURL = host
+	}
+}
+
+// currentToken returns the token to authenticate requests with, refreshing
+// it first if it is about to expire.  It returns nil if no token store is
+// configured or the store doesn't have a token yet.
+func (t *TraktTV) currentToken(ctx context.Context) (*Token, error) {
+	if t.Tokens == nil {
+		return nil, nil
+	}
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+
+	tok, err := t.Tokens.Token()
+	if err != nil || tok == nil {
+		return nil, err
+	}
+	if tok.RefreshToken != "" && tok.expiresWithin(tokenRefreshMargin) {
+		glog.Infof("Access token expires at %s, refreshing", tok.Expiry())
+		return t.refresh(ctx, tok)
+	}
+	return tok, nil
+}
+
+// saveToken stores a freshly issued token, if a token store is configured
+func (t *TraktTV) saveToken(tok *Token) error {
+	if t.Tokens == nil {
+		return nil
+	}
+	return t.Tokens.SetToken(tok)
+}
+
+// AuthorizeURL returns the url to send a user to to authorize the
+// application.  After they do, Trakt redirects them to the configured
+// redirect uri with a code to pass to ExchangeCode.
+func (t *TraktTV) AuthorizeURL(state string) (string, error) {
+	args := map[string]string{
+		"AuthHost": t.AuthURL,
+	}
+	out := bytes.Buffer{}
+	if err := AuthorizeTmpl.Execute(&out, args); err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type": {"code"},
+		"client_id":     {t.APIKey},
+		"redirect_uri":  {t.RedirectURI},
+		"state":         {state},
+	}
+	return out.String() + "?" + q.Encode(), nil
+}
+
+// ExchangeCode exchanges an authorization code for a token and saves it in
+// the token store.
+func (t *TraktTV) ExchangeCode(code string) (*Token, error) {
+	return t.ExchangeCodeContext(context.Background(), code)
+}
+
+// ExchangeCodeContext is ExchangeCode with a context that can cancel the
+// request
+func (t *TraktTV) ExchangeCodeContext(ctx context.Context, code string) (*Token, error) {
+	apiURL, err := t.getURLFromTemplate(TokenTmpl, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]string{
+		"code":          code,
+		"client_id":     t.APIKey,
+		"client_secret": t.ClientSecret,
+		"redirect_uri":  t.RedirectURI,
+		"grant_type":    "authorization_code",
+	}
+	tok := &Token{}
+	err = t.oauthPost(ctx, apiURL, payload, tok)
+	if err != nil {
+		return nil, err
+	}
+	return tok, t.saveToken(tok)
+}
+
+// RefreshToken exchanges the refresh token held by the token store for a
+// new token and saves it.  Trakt rotates refresh tokens, so the old one
+// can't be used again.
+func (t *TraktTV) RefreshToken() (*Token, error) {
+	return t.RefreshTokenContext(context.Background())
+}
+
+// RefreshTokenContext is RefreshToken with a context that can cancel the
+// request
+func (t *TraktTV) RefreshTokenContext(ctx context.Context) (*Token, error) {
+	if t.Tokens == nil {
+		return nil, fmt.Errorf("%w: no token store configured", ErrNotAuthenticated)
+	}
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+
+	tok, err := t.Tokens.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil || tok.RefreshToken == "" {
+		return nil, fmt.Errorf("%w: no refresh token available", ErrNotAuthenticated)
+	}
+	return t.refresh(ctx, tok)
+}
+
+// refresh must be called with tokenMu held
+func (t *TraktTV) refresh(ctx context.Context, old *Token) (*Token, error) {
+	apiURL, err := t.getURLFromTemplate(TokenTmpl, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]string{
+		"refresh_token": old.RefreshToken,
+		"client_id":     t.APIKey,
+		"client_secret": t.ClientSecret,
+		"redirect_uri":  t.RedirectURI,
+		"grant_type":    "refresh_token",
+	}
+	tok := &Token{}
+	err = t.oauthPost(ctx, apiURL, payload, tok)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.ErrorDesc == "invalid_grant" {
+		// The refresh token was revoked or already used, so trying it
+		// again on every request would only fail the same way.
+		glog.Errorf("Refresh token was rejected, removing it from the token store: %s", err)
+		serr := t.saveToken(nil)
+		if serr != nil {
+			return nil, serr
+		}
+		return nil, fmt.Errorf("%w: refresh token was rejected, the user must authorize again: %v", ErrNotAuthenticated, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tok, t.saveToken(tok)
+}
+
+// RevokeToken revokes the access token held by the token store and removes
+// it from the store.
+func (t *TraktTV) RevokeToken() error {
+	return t.RevokeTokenContext(context.Background())
+}
+
+// RevokeTokenContext is RevokeToken with a context that can cancel the
+// request
+func (t *TraktTV) RevokeTokenContext(ctx context.Context) error {
+	tok, err := t.currentToken(ctx)
+	if err != nil || tok == nil {
+		return err
+	}
+	apiURL, err := t.getURLFromTemplate(RevokeTokenTmpl, map[string]string{})
+	if err != nil {
+		return err
+	}
+	payload := map[string]string{
+		"token":         tok.AccessToken,
+		"client_id":     t.APIKey,
+		"client_secret": t.ClientSecret,
+	}
+	err = t.oauthPost(ctx, apiURL, payload, &struct{}{})
+	if err != nil {
+		return err
+	}
+	return t.saveToken(nil)
+}
+
+// DeviceCode starts the device authentication flow.
+func (t *TraktTV) DeviceCode() (*DeviceCode, error) {
+	return t.DeviceCodeContext(context.Background())
+}
+
+// DeviceCodeContext is DeviceCode with a context that can cancel the request
+func (t *TraktTV) DeviceCodeContext(ctx context.Context) (*DeviceCode, error) {
+	apiURL, err := t.getURLFromTemplate(DeviceCodeTmpl, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	code := &DeviceCode{}
+	err = t.oauthPost(ctx, apiURL, map[string]string{"client_id": t.APIKey}, code)
+	return code, err
+}
+
+// PollDeviceToken polls Trakt at the interval it asked for until the user
+// has authorized the device code, then saves and returns the token.
+func (t *TraktTV) PollDeviceToken(code *DeviceCode) (*Token, error) {
+	return t.PollDeviceTokenContext(context.Background(), code)
+}
+
+// PollDeviceTokenContext is PollDeviceToken with a context that stops the
+// polling when it is cancelled
+func (t *TraktTV) PollDeviceTokenContext(ctx context.Context, code *DeviceCode) (*Token, error) {
+	apiURL, err := t.getURLFromTemplate(DeviceTokenTmpl, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]string{
+		"code":          code.DeviceCode,
+		"client_id":     t.APIKey,
+		"client_secret": t.ClientSecret,
+	}
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	deadline := time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+
+	for time.Now().Before(deadline) {
+		tok := &Token{}
+		err = t.oauthPost(ctx, apiURL, payload, tok)
+		if err == nil {
+			return tok, t.saveToken(tok)
+		}
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) {
+			return nil, err
+		}
+		switch httpErr.StatusCode {
+		case http.StatusBadRequest:
+			// Authorization still pending
+		case http.StatusTooManyRequests:
+			interval += time.Second
+		case http.StatusNotFound:
+			return nil, ErrInvalidDeviceCode
+		case http.StatusConflict:
+			return nil, ErrDeviceCodeUsed
+		case http.StatusGone:
+			return nil, ErrDeviceCodeExpired
+		case http.StatusTeapot:
+			return nil, ErrAccessDenied
+		default:
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+	return nil, ErrDeviceCodeExpired
+}
+
+// oauthPost posts to the token endpoints, which must not be sent the
+// bearer token.  A 429 isn't retried: the device token endpoint uses it to
+// ask PollDeviceToken to slow down, which it does itself.
+func (t *TraktTV) oauthPost(ctx context.Context, apiURL string, payload, result interface{}) error {
+	_, err := t.doRequest(ctx, "POST", apiURL, payload, result, nil, 0)
+	return err
+}
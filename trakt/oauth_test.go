@@ -0,0 +1,352 @@
+package trakt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func tokenJSON(access, refresh string) string {
+	return fmt.Sprintf(`{"access_token":%q,"token_type":"bearer","expires_in":7776000,"refresh_token":%q,"scope":"public","created_at":%d}`,
+		access, refresh, time.Now().Unix())
+}
+
+func TestDeviceCodeFlow(t *testing.T) {
+	defer func(d time.Duration) { defaultPollInterval = d }(defaultPollInterval)
+	defaultPollInterval = time.Millisecond
+	polls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/oauth/device/code":
+					fmt.Fprintln(w, `{"device_code":"dev123","user_code":"5055CC52","verification_url":"https://trakt.tv/activate","expires_in":600,"interval":0}`)
+				case "/oauth/device/token":
+					body := map[string]string{}
+					json.NewDecoder(r.Body).Decode(&body)
+					if body["code"] != "dev123" || body["client_secret"] != "secret" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					polls++
+					if polls < 3 {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					fmt.Fprintln(w, tokenJSON("access1", "refresh1"))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+	defer ts.Close()
+
+	store := NewMemoryTokenStore(nil)
+	trakt, err := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, store))
+	if err != nil {
+		t.Fatalf("Error creating TraktTV: %s", err)
+	}
+	code, err := trakt.DeviceCode()
+	if err != nil {
+		t.Fatalf("Error getting device code: %s", err)
+	}
+	if code.UserCode != "5055CC52" {
+		t.Fatalf("Unexpected user code: %s", code.UserCode)
+	}
+	tok, err := trakt.PollDeviceToken(code)
+	if err != nil {
+		t.Fatalf("Error polling for token: %s", err)
+	}
+	if polls != 3 {
+		t.Fatalf("Expected 3 polls, got %d", polls)
+	}
+	stored, _ := store.Token()
+	if tok.AccessToken != "access1" || stored == nil || stored.AccessToken != "access1" {
+		t.Fatalf("Token wasn't returned and stored: %#v %#v", tok, stored)
+	}
+}
+
+func TestDeviceCodeDenied(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, NewMemoryTokenStore(nil)))
+	_, err := trakt.PollDeviceToken(&DeviceCode{DeviceCode: "dev123", ExpiresIn: 600})
+	if err != ErrAccessDenied {
+		t.Fatalf("Expected ErrAccessDenied, got %v", err)
+	}
+}
+
+func TestPollDeviceTokenSlowDown(t *testing.T) {
+	defer func(d time.Duration) { defaultPollInterval = d }(defaultPollInterval)
+	defaultPollInterval = time.Millisecond
+	polls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				polls++
+				if polls == 1 {
+					w.Header().Set("Retry-After", "10")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				fmt.Fprintln(w, tokenJSON("access1", "refresh1"))
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, NewMemoryTokenStore(nil)))
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := trakt.PollDeviceTokenContext(ctx, &DeviceCode{DeviceCode: "dev123", ExpiresIn: 600})
+	if err != nil {
+		t.Fatalf("Error polling for token: %s", err)
+	}
+	if polls != 2 {
+		t.Fatalf("Expected 2 polls, got %d", polls)
+	}
+	// The slow down adds a second to the interval rather than waiting
+	// the 10s Retry-After in the rate limiter
+	if d := time.Since(start); d < time.Second || d > 2*time.Second {
+		t.Fatalf("Expected the poll to back off by a second, took %s", d)
+	}
+}
+
+func TestExchangeCode(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]string{}
+				json.NewDecoder(r.Body).Decode(&body)
+				if r.URL.Path != "/oauth/token" || body["grant_type"] != "authorization_code" || body["code"] != "abc" {
+					w.WriteHeader(http.StatusUnauthorized)
+					fmt.Fprintln(w, `{"error":"invalid_grant","error_description":"The provided authorization grant is invalid"}`)
+					return
+				}
+				fmt.Fprintln(w, tokenJSON("access1", "refresh1"))
+			}))
+	defer ts.Close()
+
+	store := NewMemoryTokenStore(nil)
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, store))
+	_, err := trakt.ExchangeCode("wrong")
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.ErrorDesc != "invalid_grant" {
+		t.Fatalf("Expected invalid_grant HTTPError, got %#v", err)
+	}
+	tok, err := trakt.ExchangeCode("abc")
+	if err != nil {
+		t.Fatalf("Error exchanging code: %s", err)
+	}
+	if tok.RefreshToken != "refresh1" {
+		t.Fatalf("Unexpected refresh token: %s", tok.RefreshToken)
+	}
+}
+
+func TestAutomaticRefresh(t *testing.T) {
+	refreshes := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					body := map[string]string{}
+					json.NewDecoder(r.Body).Decode(&body)
+					if body["grant_type"] != "refresh_token" || body["refresh_token"] != "refresh1" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					if r.Header.Get("Authorization") != "" {
+						t.Errorf("Token endpoint shouldn't be sent a bearer token")
+					}
+					refreshes++
+					fmt.Fprintln(w, tokenJSON("access2", "refresh2"))
+					return
+				}
+				if r.Header.Get("Authorization") != "Bearer access2" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	expired := &Token{
+		AccessToken:  "access1",
+		RefreshToken: "refresh1",
+		ExpiresIn:    7200,
+		CreatedAt:    time.Now().Add(-2 * time.Hour).Unix(),
+	}
+	store := NewMemoryTokenStore(expired)
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, store))
+
+	for i := 0; i < 2; i++ {
+		_, err := trakt.ShowSearch("battlestar")
+		if err != nil {
+			t.Fatalf("Error searching with refreshed token: %s", err)
+		}
+	}
+	if refreshes != 1 {
+		t.Fatalf("Expected exactly one refresh, got %d", refreshes)
+	}
+	tok, _ := store.Token()
+	if tok.RefreshToken != "refresh2" {
+		t.Fatalf("Expected rotated refresh token to be stored, got %s", tok.RefreshToken)
+	}
+}
+
+func TestRevokedRefreshToken(t *testing.T) {
+	refreshes := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					refreshes++
+					w.WriteHeader(http.StatusUnauthorized)
+					fmt.Fprintln(w, `{"error":"invalid_grant","error_description":"The provided authorization grant is invalid"}`)
+					return
+				}
+				if r.Header.Get("Authorization") != "" {
+					t.Errorf("Expected public request without a token, got %q", r.Header.Get("Authorization"))
+				}
+				fmt.Fprintln(w, `{"title":"Batman","year":1989}`)
+			}))
+	defer ts.Close()
+
+	expired := &Token{
+		AccessToken:  "access1",
+		RefreshToken: "refresh1",
+		ExpiresIn:    7200,
+		CreatedAt:    time.Now().Add(-2 * time.Hour).Unix(),
+	}
+	store := NewMemoryTokenStore(expired)
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, store))
+
+	for i := 0; i < 3; i++ {
+		m, err := trakt.GetMovie("batman-1989")
+		if err != nil || m.Title != "Batman" {
+			t.Fatalf("Expected public request to work without a token, got %#v, %v", m, err)
+		}
+	}
+	if refreshes != 1 {
+		t.Fatalf("Expected the rejected refresh to be tried once, got %d", refreshes)
+	}
+	if tok, _ := store.Token(); tok != nil {
+		t.Fatalf("Expected the rejected token to be removed from the store, got %#v", tok)
+	}
+	_, _, err := trakt.GetHistory(HistoryOptions{})
+	if !errors.Is(err, ErrNotAuthenticated) {
+		t.Fatalf("Expected ErrNotAuthenticated after the refresh was rejected, got %v", err)
+	}
+}
+
+func TestRefreshInvalidClient(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					fmt.Fprintln(w, `{"error":"invalid_client","error_description":"Client authentication failed"}`)
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	expired := &Token{
+		AccessToken:  "access1",
+		RefreshToken: "refresh1",
+		ExpiresIn:    7200,
+		CreatedAt:    time.Now().Add(-2 * time.Hour).Unix(),
+	}
+	store := NewMemoryTokenStore(expired)
+	trakt, _ := New("testing", Host(ts.URL), OAuth("wrongsecret", OutOfBandRedirectURI, store))
+
+	_, _, err := trakt.GetHistory(HistoryOptions{})
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.ErrorDesc != "invalid_client" || errors.Is(err, ErrNotAuthenticated) {
+		t.Fatalf("Expected the invalid_client HTTPError, got %#v", err)
+	}
+	if tok, _ := store.Token(); tok == nil || tok.RefreshToken != "refresh1" {
+		t.Fatalf("Expected the refresh token to be kept, got %#v", tok)
+	}
+}
+
+func TestAuthorizeURL(t *testing.T) {
+	trakt, _ := New("client id", OAuth("secret", "http://localhost/cb", nil))
+	u, err := trakt.AuthorizeURL("xyz")
+	if err != nil {
+		t.Fatalf("Error building authorize url: %s", err)
+	}
+	expected := "https://trakt.tv/oauth/authorize?client_id=client+id&redirect_uri=http%3A%2F%2Flocalhost%2Fcb&response_type=code&state=xyz"
+	if u != expected {
+		t.Fatalf("Expected %s, got %s", expected, u)
+	}
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotrakt")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewFileTokenStore(filepath.Join(dir, "token.json"))
+	tok, err := store.Token()
+	if tok != nil || err != nil {
+		t.Fatalf("Expected no token from a missing file, got %#v, %v", tok, err)
+	}
+	err = store.SetToken(&Token{AccessToken: "access1", RefreshToken: "refresh1"})
+	if err != nil {
+		t.Fatalf("Error saving token: %s", err)
+	}
+	info, err := os.Stat(store.Path)
+	if err != nil {
+		t.Fatalf("Error checking token file: %s", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected token file to have mode 0600, got %s", info.Mode())
+	}
+	tok, err = store.Token()
+	if err != nil || tok.AccessToken != "access1" {
+		t.Fatalf("Unexpected token read back: %#v, %v", tok, err)
+	}
+	err = store.SetToken(nil)
+	if err != nil {
+		t.Fatalf("Error clearing token: %s", err)
+	}
+	files, _ := ioutil.ReadDir(dir)
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), "token.json") {
+			t.Fatalf("Expected token file to be removed, found %s", f.Name())
+		}
+	}
+}
+
+func TestPollDeviceTokenCancel(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), OAuth("secret", OutOfBandRedirectURI, NewMemoryTokenStore(nil)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := trakt.PollDeviceTokenContext(ctx, &DeviceCode{DeviceCode: "dev123", ExpiresIn: 600, Interval: 5})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected polling to stop with the context, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package trakt
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Pagination is the paging information Trakt sends with list responses
+type Pagination struct {
+	Page      int
+	Limit     int
+	PageCount int
+	ItemCount int
+}
+
+// parsePagination reads the X-Pagination-* headers, returning nil if the
+// response wasn't paginated.
+func parsePagination(h http.Header) *Pagination {
+	if h.Get("X-Pagination-Page") == "" {
+		return nil
+	}
+	p := &Pagination{}
+	p.Page, _ = strconv.Atoi(h.Get("X-Pagination-Page"))
+	p.Limit, _ = strconv.Atoi(h.Get("X-Pagination-Limit"))
+	p.PageCount, _ = strconv.Atoi(h.Get("X-Pagination-Page-Count"))
+	p.ItemCount, _ = strconv.Atoi(h.Get("X-Pagination-Item-Count"))
+	return p
+}
+
+// pageParams sets the page and limit query params when they're given
+func pageParams(params url.Values, page, limit int) {
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+}
+
+// PageFunc fetches one page of a paginated endpoint
+type PageFunc[T any] func(ctx context.Context, page, limit int) ([]T, *Pagination, error)
+
+// Paginator walks the items of a paginated endpoint, fetching pages as
+// they're needed:
+//
+//	p := t.PaginateHistory(ctx, trakt.HistoryOptions{Limit: 100})
+//	for p.Next() {
+//		item := p.Item()
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// A Paginator isn't safe to use from multiple goroutines.
+type Paginator[T any] struct {
+	ctx   context.Context
+	fetch PageFunc[T]
+	page  int
+	limit int
+
+	items      []T
+	idx        int
+	pagination *Pagination
+	done       bool
+	err        error
+}
+
+// Paginate returns a Paginator fetching pages of pageSize items with
+// fetch, starting from the first page.  A pageSize of 0 uses Trakt's
+// default of 10.  The Paginate methods on TraktTV cover the endpoints
+// with their own options; Paginate is for wrapping anything else.
+func Paginate[T any](ctx context.Context, pageSize int, fetch PageFunc[T]) *Paginator[T] {
+	return &Paginator[T]{ctx: ctx, fetch: fetch, limit: pageSize}
+}
+
+// startingAt makes the paginator start from page instead of the first
+func (p *Paginator[T]) startingAt(page int) *Paginator[T] {
+	if page > 1 {
+		p.page = page - 1
+	}
+	return p
+}
+
+// Next moves to the next item, fetching the next page if needed.  It
+// returns false when there are no more items or fetching failed, which Err
+// tells apart.
+func (p *Paginator[T]) Next() bool {
+	if p.idx+1 < len(p.items) {
+		p.idx++
+		return true
+	}
+	if p.done || p.err != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+	p.page++
+	items, pagination, err := p.fetch(p.ctx, p.page, p.limit)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.items, p.idx, p.pagination = items, 0, pagination
+	// Endpoints that aren't paginated return everything at once
+	if pagination == nil || p.page >= pagination.PageCount || len(items) == 0 {
+		p.done = true
+	}
+	return len(items) > 0
+}
+
+// Item returns the current item
+func (p *Paginator[T]) Item() T {
+	return p.items[p.idx]
+}
+
+// Err returns the error that stopped Next, if any
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// Pagination returns the paging information from the last page fetched,
+// with the total number of items and pages.  It's nil until Next has been
+// called, or if the endpoint isn't paginated.
+func (p *Paginator[T]) Pagination() *Pagination {
+	return p.pagination
+}
+
+// PaginateHistory walks the user's whole watch history, starting from
+// opts.Page and fetching opts.Limit items at a time.
+func (t *TraktTV) PaginateHistory(ctx context.Context, opts HistoryOptions, callOpts ...CallOption) *Paginator[HistoryItem] {
+	return Paginate(ctx, opts.Limit, func(ctx context.Context, page, limit int) ([]HistoryItem, *Pagination, error) {
+		opts.Page, opts.Limit = page, limit
+		return t.GetHistoryContext(ctx, opts, callOpts...)
+	}).startingAt(opts.Page)
+}
+
+// PaginateWatchlist walks the user's whole watchlist, starting from
+// opts.Page and fetching opts.Limit items at a time.
+func (t *TraktTV) PaginateWatchlist(ctx context.Context, opts WatchlistOptions, callOpts ...CallOption) *Paginator[WatchlistItem] {
+	return Paginate(ctx, opts.Limit, func(ctx context.Context, page, limit int) ([]WatchlistItem, *Pagination, error) {
+		opts.Page, opts.Limit = page, limit
+		return t.GetWatchlistContext(ctx, opts, callOpts...)
+	}).startingAt(opts.Page)
+}
+
+// PaginateRatings walks all of the user's ratings, starting from
+// opts.Page and fetching opts.Limit items at a time.
+func (t *TraktTV) PaginateRatings(ctx context.Context, opts RatingsOptions, callOpts ...CallOption) *Paginator[RatedItem] {
+	return Paginate(ctx, opts.Limit, func(ctx context.Context, page, limit int) ([]RatedItem, *Pagination, error) {
+		opts.Page, opts.Limit = page, limit
+		return t.GetRatingsContext(ctx, opts, callOpts...)
+	}).startingAt(opts.Page)
+}
+
+// PaginateListItems walks all of the items on a list, starting from
+// opts.Page and fetching opts.Limit items at a time.
+func (t *TraktTV) PaginateListItems(ctx context.Context, user, listID string, opts ListItemsOptions, callOpts ...CallOption) *Paginator[ListItem] {
+	return Paginate(ctx, opts.Limit, func(ctx context.Context, page, limit int) ([]ListItem, *Pagination, error) {
+		opts.Page, opts.Limit = page, limit
+		return t.GetListItemsContext(ctx, user, listID, opts, callOpts...)
+	}).startingAt(opts.Page)
+}
+
+// PaginateSearch walks all of the results of a search, starting from
+// opts.Page and fetching opts.Limit results at a time.
+func (t *TraktTV) PaginateSearch(ctx context.Context, query string, opts SearchOptions, callOpts ...CallOption) *Paginator[SearchResult] {
+	return Paginate(ctx, opts.Limit, func(ctx context.Context, page, limit int) ([]SearchResult, *Pagination, error) {
+		opts.Page, opts.Limit = page, limit
+		return t.SearchContext(ctx, query, opts, callOpts...)
+	}).startingAt(opts.Page)
+}
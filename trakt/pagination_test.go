@@ -0,0 +1,101 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// pagedServer serves history pages of limit items out of total
+func pagedServer(total int, requests *[]string) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				*requests = append(*requests, r.URL.RawQuery)
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+				pages := (total + limit - 1) / limit
+				w.Header().Set("X-Pagination-Page", strconv.Itoa(page))
+				w.Header().Set("X-Pagination-Limit", strconv.Itoa(limit))
+				w.Header().Set("X-Pagination-Page-Count", strconv.Itoa(pages))
+				w.Header().Set("X-Pagination-Item-Count", strconv.Itoa(total))
+				fmt.Fprint(w, "[")
+				for i := (page - 1) * limit; i < page*limit && i < total; i++ {
+					if i > (page-1)*limit {
+						fmt.Fprint(w, ",")
+					}
+					fmt.Fprintf(w, `{"id":%d,"type":"movie"}`, i)
+				}
+				fmt.Fprint(w, "]")
+			}))
+}
+
+func TestPaginator(t *testing.T) {
+	requests := []string{}
+	ts := pagedServer(7, &requests)
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	p := trakt.PaginateHistory(context.Background(), HistoryOptions{Limit: 3})
+	seen := 0
+	for p.Next() {
+		if p.Item().ID != int64(seen) {
+			t.Fatalf("Expected item %d, got %d", seen, p.Item().ID)
+		}
+		seen++
+	}
+	if err := p.Err(); err != nil {
+		t.Fatalf("Error paginating: %s", err)
+	}
+	if seen != 7 {
+		t.Fatalf("Expected 7 items, got %d", seen)
+	}
+	if len(requests) != 3 || p.Pagination().ItemCount != 7 || p.Pagination().PageCount != 3 {
+		t.Fatalf("Expected 3 pages to be fetched, got %v and %#v", requests, p.Pagination())
+	}
+	if p.Next() {
+		t.Fatal("Expected Next to keep returning false at the end")
+	}
+}
+
+func TestPaginatorStartPage(t *testing.T) {
+	requests := []string{}
+	ts := pagedServer(7, &requests)
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	p := trakt.PaginateHistory(context.Background(), HistoryOptions{Page: 3, Limit: 3})
+	if !p.Next() || p.Item().ID != 6 || p.Next() {
+		t.Fatalf("Expected only the last item from page 3")
+	}
+	if len(requests) != 1 || requests[0] != "extended=full&limit=3&page=3" {
+		t.Fatalf("Unexpected requests: %v", requests)
+	}
+}
+
+func TestPaginatorCancel(t *testing.T) {
+	requests := []string{}
+	ts := pagedServer(7, &requests)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	trakt := authedClient(ts.URL)
+	p := trakt.PaginateHistory(ctx, HistoryOptions{Limit: 3})
+	for i := 0; i < 3; i++ {
+		p.Next()
+	}
+	cancel()
+	if p.Next() {
+		t.Fatal("Expected Next to stop once the context was cancelled")
+	}
+	if !errors.Is(p.Err(), context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %#v", p.Err())
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Expected no requests after cancelling, got %v", requests)
+	}
+}
@@ -0,0 +1,157 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+)
+
+// https://trakt.docs.apiary.io/#reference/people/summary
+var PersonTmpl = template.Must(
+	template.New("Person").Parse("{{.Host}}/people/{{.Query | urlquery}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/people/movies
+var PersonMoviesTmpl = template.Must(
+	template.New("PersonMovies").Parse("{{.Host}}/people/{{.Query | urlquery}}/movies"),
+)
+
+// https://trakt.docs.apiary.io/#reference/people/shows
+var PersonShowsTmpl = template.Must(
+	template.New("PersonShows").Parse("{{.Host}}/people/{{.Query | urlquery}}/shows"),
+)
+
+// https://trakt.docs.apiary.io/#reference/movies/people
+var MoviePeopleTmpl = template.Must(
+	template.New("MoviePeople").Parse("{{.Host}}/movies/{{.Query | urlquery}}/people"),
+)
+
+// https://trakt.docs.apiary.io/#reference/shows/people
+var ShowPeopleTmpl = template.Must(
+	template.New("ShowPeople").Parse("{{.Host}}/shows/{{.Query | urlquery}}/people"),
+)
+
+// Crew departments, the keys of Credits.Crew
+const (
+	DepartmentProduction    = "production"
+	DepartmentArt           = "art"
+	DepartmentCrew          = "crew"
+	DepartmentCostumeMakeUp = "costume & make-up"
+	DepartmentDirecting     = "directing"
+	DepartmentWriting       = "writing"
+	DepartmentSound         = "sound"
+	DepartmentCamera        = "camera"
+	DepartmentVisualEffects = "visual effects"
+	DepartmentLighting      = "lighting"
+	DepartmentEditing       = "editing"
+	DepartmentCreatedBy     = "created by"
+)
+
+// CastCredit is an acting role.  A person's credits have the Movie or Show
+// set, a movie or show's people have the Person set.
+type CastCredit struct {
+	Characters []string `json:"characters"`
+	// EpisodeCount and SeriesRegular are only set for shows
+	EpisodeCount  int     `json:"episode_count"`
+	SeriesRegular bool    `json:"series_regular"`
+	Movie         *Movie  `json:"movie"`
+	Show          *Show   `json:"show"`
+	Person        *Person `json:"person"`
+}
+
+// CrewCredit is a crew role.  A person's credits have the Movie or Show
+// set, a movie or show's people have the Person set.
+type CrewCredit struct {
+	Jobs []string `json:"jobs"`
+	// EpisodeCount is only set for shows
+	EpisodeCount int     `json:"episode_count"`
+	Movie        *Movie  `json:"movie"`
+	Show         *Show   `json:"show"`
+	Person       *Person `json:"person"`
+}
+
+// Credits are the cast and crew of a movie, show or episode, or the roles a
+// person had.  Crew is grouped by the Department constants.
+type Credits struct {
+	Cast []CastCredit `json:"cast"`
+	// GuestStars is only set for episodes
+	GuestStars []CastCredit            `json:"guest_stars"`
+	Crew       map[string][]CrewCredit `json:"crew"`
+}
+
+// GetPerson returns a person given their slug or Trakt or IMDB id.  The
+// details are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetPerson(slugOrID string, opts ...CallOption) (*Person, error) {
+	return t.GetPersonContext(context.Background(), slugOrID, opts...)
+}
+
+// GetPersonContext is GetPerson with a context that can cancel the request
+func (t *TraktTV) GetPersonContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Person, error) {
+	res := &Person{}
+	args := map[string]string{
+		"Query": slugOrID,
+	}
+	apiURL, err := t.getURLWithQuery(PersonTmpl, args, url.Values{"extended": {extendedArg(opts, ExtendedFull)}})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
+
+// GetPersonMovieCredits returns the movies a person was in or worked on
+func (t *TraktTV) GetPersonMovieCredits(slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.GetPersonMovieCreditsContext(context.Background(), slugOrID, opts...)
+}
+
+// GetPersonMovieCreditsContext is GetPersonMovieCredits with a context that
+// can cancel the request
+func (t *TraktTV) GetPersonMovieCreditsContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.credits(ctx, PersonMoviesTmpl, slugOrID, opts)
+}
+
+// GetPersonShowCredits returns the shows a person was in or worked on
+func (t *TraktTV) GetPersonShowCredits(slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.GetPersonShowCreditsContext(context.Background(), slugOrID, opts...)
+}
+
+// GetPersonShowCreditsContext is GetPersonShowCredits with a context that
+// can cancel the request
+func (t *TraktTV) GetPersonShowCreditsContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.credits(ctx, PersonShowsTmpl, slugOrID, opts)
+}
+
+// GetMoviePeople returns the cast and crew of a movie
+func (t *TraktTV) GetMoviePeople(slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.GetMoviePeopleContext(context.Background(), slugOrID, opts...)
+}
+
+// GetMoviePeopleContext is GetMoviePeople with a context that can cancel
+// the request
+func (t *TraktTV) GetMoviePeopleContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.credits(ctx, MoviePeopleTmpl, slugOrID, opts)
+}
+
+// GetShowPeople returns the regular cast and crew of a show
+func (t *TraktTV) GetShowPeople(slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.GetShowPeopleContext(context.Background(), slugOrID, opts...)
+}
+
+// GetShowPeopleContext is GetShowPeople with a context that can cancel the
+// request
+func (t *TraktTV) GetShowPeopleContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Credits, error) {
+	return t.credits(ctx, ShowPeopleTmpl, slugOrID, opts)
+}
+
+func (t *TraktTV) credits(ctx context.Context, tmpl *template.Template, slugOrID string, opts []CallOption) (*Credits, error) {
+	res := &Credits{}
+	args := map[string]string{
+		"Query": slugOrID,
+	}
+	apiURL, err := t.getURLWithQuery(tmpl, args, url.Values{"extended": {extendedArg(opts)}})
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
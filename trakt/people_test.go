@@ -0,0 +1,78 @@
+package trakt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPerson(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/people/bryan-cranston" || r.URL.Query().Get("extended") != "full" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"name":"Bryan Cranston","ids":{"trakt":297737,"slug":"bryan-cranston","imdb":"nm0186505","tmdb":17419},"biography":"Bryan Lee Cranston is an American actor.","birthday":"1956-03-07","death":null,"birthplace":"San Fernando Valley, California, USA","homepage":"http://www.bryancranston.com/","gender":"male","known_for_department":"acting","updated_at":"2022-11-03T17:00:54.000Z"}`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	p, err := trakt.GetPerson("bryan-cranston")
+	if err != nil {
+		t.Fatalf("Error getting person: %s", err)
+	}
+	if p.Name != "Bryan Cranston" || p.IDs.Imdb != "nm0186505" || p.Birthday != "1956-03-07" || p.KnownForDepartment != "acting" {
+		t.Fatalf("Unexpected person: %#v", p)
+	}
+}
+
+func TestPersonShowCredits(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/people/bryan-cranston/shows" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"cast":[{"characters":["Walter White"],"episode_count":62,"series_regular":true,"show":{"title":"Breaking Bad","year":2008,"ids":{"trakt":1}}}],"crew":{"production":[{"jobs":["Producer"],"episode_count":50,"show":{"title":"Breaking Bad","year":2008,"ids":{"trakt":1}}}],"directing":[{"jobs":["Director"],"episode_count":3,"show":{"title":"Breaking Bad","year":2008,"ids":{"trakt":1}}}]}}`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	c, err := trakt.GetPersonShowCredits("bryan-cranston")
+	if err != nil {
+		t.Fatalf("Error getting credits: %s", err)
+	}
+	if len(c.Cast) != 1 || c.Cast[0].Characters[0] != "Walter White" || c.Cast[0].EpisodeCount != 62 || c.Cast[0].Show.Title != "Breaking Bad" {
+		t.Fatalf("Unexpected cast: %#v", c.Cast)
+	}
+	directing := c.Crew[DepartmentDirecting]
+	if len(c.Crew) != 2 || len(directing) != 1 || directing[0].Jobs[0] != "Director" {
+		t.Fatalf("Unexpected crew: %#v", c.Crew)
+	}
+}
+
+func TestMoviePeople(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/movies/batman-1989/people" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"cast":[{"characters":["Jack Napier","The Joker"],"person":{"name":"Jack Nicholson","ids":{"trakt":1}}}],"crew":{"directing":[{"jobs":["Director"],"person":{"name":"Tim Burton","ids":{"trakt":2}}}]}}`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	c, err := trakt.GetMoviePeople("batman-1989")
+	if err != nil {
+		t.Fatalf("Error getting people: %s", err)
+	}
+	if len(c.Cast) != 1 || len(c.Cast[0].Characters) != 2 || c.Cast[0].Person.Name != "Jack Nicholson" {
+		t.Fatalf("Unexpected cast: %#v", c.Cast)
+	}
+	if c.Crew[DepartmentDirecting][0].Person.Name != "Tim Burton" {
+		t.Fatalf("Unexpected crew: %#v", c.Crew)
+	}
+}
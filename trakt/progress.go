@@ -0,0 +1,113 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/shows/watched-progress
+var WatchedProgressTmpl = template.Must(
+	template.New("WatchedProgress").Parse("{{.Host}}/shows/{{.Query | urlquery}}/progress/watched"),
+)
+
+// https://trakt.docs.apiary.io/#reference/shows/collection-progress
+var CollectedProgressTmpl = template.Must(
+	template.New("CollectedProgress").Parse("{{.Host}}/shows/{{.Query | urlquery}}/progress/collection"),
+)
+
+// ProgressOptions says what to count in a show's progress
+type ProgressOptions struct {
+	// Hidden includes the seasons the user has hidden
+	Hidden bool
+	// Specials includes season 0
+	Specials bool
+	// CountSpecials says whether specials count towards the show's
+	// Aired and Completed when Specials is set.  Trakt counts them if
+	// nil.
+	CountSpecials *bool
+}
+
+// ShowProgress is how far the user has got through watching or collecting
+// a show
+type ShowProgress struct {
+	Aired     int `json:"aired"`
+	Completed int `json:"completed"`
+	// LastWatchedAt is only set for watched progress
+	LastWatchedAt *time.Time `json:"last_watched_at"`
+	// LastCollectedAt is only set for collected progress
+	LastCollectedAt *time.Time `json:"last_collected_at"`
+	// ResetAt is when the user last started the show over, if they have
+	ResetAt       *time.Time       `json:"reset_at"`
+	Seasons       []SeasonProgress `json:"seasons"`
+	HiddenSeasons []Season         `json:"hidden_seasons"`
+	// NextEpisode is the next episode to watch or collect, nil once
+	// the show is complete
+	NextEpisode *Episode `json:"next_episode"`
+	// LastEpisode is the episode last watched or collected
+	LastEpisode *Episode `json:"last_episode"`
+}
+
+// SeasonProgress is how far the user has got through a season
+type SeasonProgress struct {
+	Number    int               `json:"number"`
+	Title     string            `json:"title"`
+	Aired     int               `json:"aired"`
+	Completed int               `json:"completed"`
+	Episodes  []EpisodeProgress `json:"episodes"`
+}
+
+// EpisodeProgress is whether the user has watched or collected an episode
+type EpisodeProgress struct {
+	Number    int  `json:"number"`
+	Completed bool `json:"completed"`
+	// LastWatchedAt is only set for watched progress
+	LastWatchedAt *time.Time `json:"last_watched_at"`
+	// CollectedAt is only set for collected progress
+	CollectedAt *time.Time `json:"collected_at"`
+}
+
+// WatchedProgress returns how much of a show the user has watched
+func (t *TraktTV) WatchedProgress(slugOrID string, opts ProgressOptions) (*ShowProgress, error) {
+	return t.WatchedProgressContext(context.Background(), slugOrID, opts)
+}
+
+// WatchedProgressContext is WatchedProgress with a context that can cancel
+// the request
+func (t *TraktTV) WatchedProgressContext(ctx context.Context, slugOrID string, opts ProgressOptions) (*ShowProgress, error) {
+	return t.progress(ctx, WatchedProgressTmpl, slugOrID, opts)
+}
+
+// CollectedProgress returns how much of a show the user has collected
+func (t *TraktTV) CollectedProgress(slugOrID string, opts ProgressOptions) (*ShowProgress, error) {
+	return t.CollectedProgressContext(context.Background(), slugOrID, opts)
+}
+
+// CollectedProgressContext is CollectedProgress with a context that can
+// cancel the request
+func (t *TraktTV) CollectedProgressContext(ctx context.Context, slugOrID string, opts ProgressOptions) (*ShowProgress, error) {
+	return t.progress(ctx, CollectedProgressTmpl, slugOrID, opts)
+}
+
+// progress gets the user's watched or collected progress for a show
+func (t *TraktTV) progress(ctx context.Context, tmpl *template.Template, slugOrID string, opts ProgressOptions) (*ShowProgress, error) {
+	res := &ShowProgress{}
+	args := map[string]string{
+		"Query": slugOrID,
+	}
+	params := url.Values{
+		"hidden":   {strconv.FormatBool(opts.Hidden)},
+		"specials": {strconv.FormatBool(opts.Specials)},
+	}
+	if opts.CountSpecials != nil {
+		params.Set("count_specials", strconv.FormatBool(*opts.CountSpecials))
+	}
+	apiURL, err := t.getURLWithQuery(tmpl, args, params)
+	if err != nil {
+		return res, err
+	}
+	_, err = t.getAuthenticated(ctx, apiURL, res)
+	return res, err
+}
@@ -0,0 +1,70 @@
+package trakt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWatchedProgress(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/shows/the-wire/progress/watched" ||
+					r.URL.RawQuery != "count_specials=false&hidden=false&specials=true" ||
+					r.Header.Get("Authorization") != "Bearer access1" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"aired":8,"completed":6,"last_watched_at":"2015-03-21T19:03:58.000Z","reset_at":null,`+
+					`"seasons":[{"number":1,"title":"The Target","aired":8,"completed":6,"episodes":[{"number":1,"completed":true,"last_watched_at":"2015-03-21T19:03:58.000Z"},{"number":7,"completed":false,"last_watched_at":null}]}],`+
+					`"hidden_seasons":[{"number":2,"ids":{"trakt":3051}}],`+
+					`"next_episode":{"season":1,"number":7,"title":"One Arrest","ids":{"trakt":62}},`+
+					`"last_episode":{"season":1,"number":6,"title":"The Wire","ids":{"trakt":61}}}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	countSpecials := false
+	p, err := trakt.WatchedProgress("the-wire", ProgressOptions{Specials: true, CountSpecials: &countSpecials})
+	if err != nil {
+		t.Fatalf("Error getting progress: %s", err)
+	}
+	if p.Aired != 8 || p.Completed != 6 || p.ResetAt != nil ||
+		p.LastWatchedAt == nil || !p.LastWatchedAt.Equal(time.Date(2015, 3, 21, 19, 3, 58, 0, time.UTC)) {
+		t.Fatalf("Unexpected progress: %#v", p)
+	}
+	if len(p.Seasons) != 1 || len(p.Seasons[0].Episodes) != 2 || !p.Seasons[0].Episodes[0].Completed ||
+		p.Seasons[0].Episodes[1].LastWatchedAt != nil {
+		t.Fatalf("Unexpected season progress: %#v", p.Seasons)
+	}
+	if len(p.HiddenSeasons) != 1 || p.HiddenSeasons[0].Number != 2 {
+		t.Fatalf("Unexpected hidden seasons: %#v", p.HiddenSeasons)
+	}
+	if p.NextEpisode == nil || p.NextEpisode.Number != 7 || p.LastEpisode == nil || p.LastEpisode.Number != 6 {
+		t.Fatalf("Unexpected next/last episode: %#v %#v", p.NextEpisode, p.LastEpisode)
+	}
+}
+
+func TestCollectedProgress(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/shows/the-wire/progress/collection" || r.URL.RawQuery != "hidden=false&specials=false" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"aired":8,"completed":8,"last_collected_at":"2015-03-21T19:03:58.000Z","seasons":[{"number":1,"aired":8,"completed":8,"episodes":[{"number":1,"completed":true,"collected_at":"2015-03-21T19:03:58.000Z"}]}],"next_episode":null}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	p, err := trakt.CollectedProgress("the-wire", ProgressOptions{})
+	if err != nil {
+		t.Fatalf("Error getting progress: %s", err)
+	}
+	if p.Completed != 8 || p.LastCollectedAt == nil || p.NextEpisode != nil ||
+		p.Seasons[0].Episodes[0].CollectedAt == nil {
+		t.Fatalf("Unexpected progress: %#v", p)
+	}
+}
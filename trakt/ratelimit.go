@@ -0,0 +1,168 @@
+package trakt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Trakt allows 1000 GET requests every 5 minutes.  New limits clients to
+// that rate by default.
+const (
+	DefaultRateLimit = 1000.0 / 300.0
+	DefaultRateBurst = 20
+)
+
+// DefaultRateLimitRetries is how many times a request that got a 429 Too
+// Many Requests response is retried before giving up.
+const DefaultRateLimitRetries = 3
+
+// defaultRetryAfter is how long to wait after a 429 that didn't say when to
+// retry.
+const defaultRetryAfter = 10 * time.Second
+
+// RateLimit limits the client to requestsPerSecond requests per second,
+// allowing bursts of up to burst requests.  A rate of 0 disables client side
+// limiting, though 429 responses are still honored.
+func RateLimit(requestsPerSecond float64, burst int) option {
+	return func(t *TraktTV) {
+		t.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
+
+// RateLimitRetries sets how many times a request is retried after a 429 Too
+// Many Requests response.
+func RateLimitRetries(n int) option {
+	return func(t *TraktTV) {
+		t.rateLimitRetries = n
+	}
+}
+
+// rateLimiter is a token bucket shared by every request a client makes.  It
+// can also be paused until a given time when Trakt tells us to back off.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before
+// using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	if now.Before(l.pausedUntil) {
+		wait = l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return wait
+	}
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens < 0 {
+		tokenWait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+		if tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+	return wait
+}
+
+// wait blocks until the caller may send a request or ctx is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	return sleepContext(ctx, l.reserve())
+}
+
+// pauseUntil holds back every request until the given time
+func (l *rateLimiter) pauseUntil(until time.Time) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// sleepContext sleeps for d or until ctx is done, whichever is first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitHeader is the JSON Trakt sends in the X-Ratelimit header
+type rateLimitHeader struct {
+	Name      string    `json:"name"`
+	Period    int       `json:"period"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Until     time.Time `json:"until"`
+}
+
+// parseRateLimit returns the parsed X-Ratelimit header, if there is one
+func parseRateLimit(h http.Header) (*rateLimitHeader, bool) {
+	v := h.Get("X-Ratelimit")
+	if v == "" {
+		return nil, false
+	}
+	rl := &rateLimitHeader{}
+	if err := json.Unmarshal([]byte(v), rl); err != nil {
+		return nil, false
+	}
+	return rl, true
+}
+
+// retryAfter works out how long to wait after a 429 response from its
+// Retry-After header, falling back to the X-Ratelimit reset time.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return at.Sub(now)
+		}
+	}
+	if rl, ok := parseRateLimit(h); ok && !rl.Until.IsZero() {
+		return rl.Until.Sub(now)
+	}
+	return defaultRetryAfter
+}
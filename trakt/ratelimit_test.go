@@ -0,0 +1,124 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter429(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	_, err := trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Expected request to succeed after retrying, got %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRateLimitHeaderBackoff(t *testing.T) {
+	calls := 0
+	var until time.Time
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls == 1 {
+					until = time.Now().Add(200 * time.Millisecond)
+					w.Header().Set("X-Ratelimit", fmt.Sprintf(`{"name":"UNAUTHED_API_GET_LIMIT","period":300,"limit":1000,"remaining":0,"until":%q}`,
+						until.UTC().Format(time.RFC3339Nano)))
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				if time.Now().Before(until) {
+					t.Errorf("Request retried before the rate limit reset")
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	_, err := trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Expected request to succeed after retrying, got %s", err)
+	}
+}
+
+func TestRateLimitRetriesExhausted(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), RateLimitRetries(2))
+	_, err := trakt.ShowSearch("battlestar")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Expected ErrRateLimited, got %#v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRateLimitWaitCancelled(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := trakt.ShowSearchContext(ctx, "battlestar")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the wait to be cut short by the context, got %v", err)
+	}
+}
+
+func TestClientSideRateLimit(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), RateLimit(20, 1))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := trakt.ShowSearch("battlestar")
+		if err != nil {
+			t.Fatalf("Error searching: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("Expected 3 requests at 20/s with a burst of 1 to take at least 100ms, took %s", elapsed)
+	}
+}
@@ -0,0 +1,241 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/get-ratings
+var RatingsTmpl = template.Must(
+	template.New("Ratings").Parse("{{.Host}}/sync/ratings{{if .Type}}/{{.Type | urlquery}}{{if .Rating}}/{{.Rating}}{{end}}{{end}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/add-ratings
+var AddRatingsTmpl = template.Must(
+	template.New("AddRatings").Parse("{{.Host}}/sync/ratings"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/remove-ratings
+var RemoveRatingsTmpl = template.Must(
+	template.New("RemoveRatings").Parse("{{.Host}}/sync/ratings/remove"),
+)
+
+// https://trakt.docs.apiary.io/#reference/movies/ratings
+var MovieRatingsTmpl = template.Must(
+	template.New("MovieRatings").Parse("{{.Host}}/movies/{{.Query | urlquery}}/ratings"),
+)
+
+// https://trakt.docs.apiary.io/#reference/shows/ratings
+var ShowRatingsTmpl = template.Must(
+	template.New("ShowRatings").Parse("{{.Host}}/shows/{{.Query | urlquery}}/ratings"),
+)
+
+// https://trakt.docs.apiary.io/#reference/seasons/ratings
+var SeasonRatingsTmpl = template.Must(
+	template.New("SeasonRatings").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season | urlquery}}/ratings"),
+)
+
+// Ratings is the community rating of an item
+type Ratings struct {
+	// Rating is the average, from 1 to 10
+	Rating float64 `json:"rating"`
+	Votes  int     `json:"votes"`
+	// Distribution is how many votes each rating from 1 to 10 got
+	Distribution map[int]int `json:"distribution"`
+}
+
+// RatedItem is one of the user's ratings
+type RatedItem struct {
+	RatedAt time.Time `json:"rated_at"`
+	Rating  int       `json:"rating"`
+	// Type is movie, show, season or episode
+	Type    string   `json:"type"`
+	Movie   *Movie   `json:"movie"`
+	Show    *Show    `json:"show"`
+	Season  *Season  `json:"season"`
+	Episode *Episode `json:"episode"`
+}
+
+// RatingsOptions filters and pages the results of GetRatings.  Zero values
+// are left out of the request.
+type RatingsOptions struct {
+	// Type is movies, shows, seasons or episodes
+	Type string
+	// Ratings limits the results to the given ratings
+	Ratings []int
+	Page    int
+	Limit   int
+}
+
+// RateMovie adds a movie rated at the given time.  A zero time means now.
+func (s *SyncItems) RateMovie(m *Movie, rating int, ratedAt time.Time) *SyncItems {
+	s.Movies = append(s.Movies, SyncItem{IDs: m.IDs, Rating: rating, RatedAt: timeOrNil(ratedAt)})
+	return s
+}
+
+// RateShow adds a show rated at the given time
+func (s *SyncItems) RateShow(show *Show, rating int, ratedAt time.Time) *SyncItems {
+	s.Shows = append(s.Shows, SyncShow{SyncItem: SyncItem{IDs: show.IDs, Rating: rating, RatedAt: timeOrNil(ratedAt)}})
+	return s
+}
+
+// RateSeason adds a season, identified by the season's IDs, rated at the
+// given time
+func (s *SyncItems) RateSeason(season *Season, rating int, ratedAt time.Time) *SyncItems {
+	s.Seasons = append(s.Seasons, SyncItem{IDs: season.IDs, Rating: rating, RatedAt: timeOrNil(ratedAt)})
+	return s
+}
+
+// RateEpisode adds an episode, identified by the episode's IDs, rated at
+// the given time
+func (s *SyncItems) RateEpisode(e *Episode, rating int, ratedAt time.Time) *SyncItems {
+	s.Episodes = append(s.Episodes, SyncItem{IDs: e.IDs, Rating: rating, RatedAt: timeOrNil(ratedAt)})
+	return s
+}
+
+// RateShowEpisode adds an episode by its show's IDs and its season and
+// episode numbers, rated at the given time
+func (s *SyncItems) RateShowEpisode(showIDs IDs, season, episode int, rating int, ratedAt time.Time) *SyncItems {
+	return s.addShowEpisode(showIDs, season, SyncShowEpisode{Number: episode, Rating: rating, RatedAt: timeOrNil(ratedAt)})
+}
+
+// validRating reports if r is a rating Trakt accepts
+func validRating(r int) bool {
+	return r >= 1 && r <= 10
+}
+
+// checkRatings makes sure every item in items has a valid rating
+func (s *SyncItems) checkRatings() error {
+	for _, list := range [][]SyncItem{s.Movies, s.Seasons, s.Episodes} {
+		for _, i := range list {
+			if !validRating(i.Rating) {
+				return ErrInvalidRating
+			}
+		}
+	}
+	for _, show := range s.Shows {
+		if len(show.Seasons) == 0 && !validRating(show.Rating) {
+			return ErrInvalidRating
+		}
+		for _, season := range show.Seasons {
+			if len(season.Episodes) == 0 && !validRating(season.Rating) {
+				return ErrInvalidRating
+			}
+			for _, e := range season.Episodes {
+				if !validRating(e.Rating) {
+					return ErrInvalidRating
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetRatings returns the user's ratings along with the pagination Trakt
+// returned, which is nil unless a page or limit was asked for.  The items
+// are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetRatings(opts RatingsOptions, callOpts ...CallOption) ([]RatedItem, *Pagination, error) {
+	return t.GetRatingsContext(context.Background(), opts, callOpts...)
+}
+
+// GetRatingsContext is GetRatings with a context that can cancel the
+// request
+func (t *TraktTV) GetRatingsContext(ctx context.Context, opts RatingsOptions, callOpts ...CallOption) ([]RatedItem, *Pagination, error) {
+	res := []RatedItem{}
+	ratings := make([]string, len(opts.Ratings))
+	for i, r := range opts.Ratings {
+		if !validRating(r) {
+			return res, nil, ErrInvalidRating
+		}
+		ratings[i] = strconv.Itoa(r)
+	}
+	args := map[string]string{
+		"Type":   opts.Type,
+		"Rating": strings.Join(ratings, ","),
+	}
+	if len(ratings) > 0 && opts.Type == "" {
+		args["Type"] = "all"
+	}
+	params := url.Values{"extended": {extendedArg(callOpts, ExtendedFull)}}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(RatingsTmpl, args, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getAuthenticated(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// AddRatings rates the items, replacing any earlier rating.  Every item
+// must have a rating from 1 to 10.
+func (t *TraktTV) AddRatings(items *SyncItems) (*SyncResult, error) {
+	return t.AddRatingsContext(context.Background(), items)
+}
+
+// AddRatingsContext is AddRatings with a context that can cancel the
+// request
+func (t *TraktTV) AddRatingsContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	if err := items.checkRatings(); err != nil {
+		return &SyncResult{}, err
+	}
+	return t.sync(ctx, AddRatingsTmpl, items)
+}
+
+// RemoveRatings removes the user's ratings of the items.  The items'
+// Rating fields are ignored.
+func (t *TraktTV) RemoveRatings(items *SyncItems) (*SyncResult, error) {
+	return t.RemoveRatingsContext(context.Background(), items)
+}
+
+// RemoveRatingsContext is RemoveRatings with a context that can cancel the
+// request
+func (t *TraktTV) RemoveRatingsContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, RemoveRatingsTmpl, items)
+}
+
+// GetMovieRatings returns the community ratings of a movie
+func (t *TraktTV) GetMovieRatings(slugOrID string) (*Ratings, error) {
+	return t.GetMovieRatingsContext(context.Background(), slugOrID)
+}
+
+// GetMovieRatingsContext is GetMovieRatings with a context that can cancel
+// the request
+func (t *TraktTV) GetMovieRatingsContext(ctx context.Context, slugOrID string) (*Ratings, error) {
+	return t.ratings(ctx, MovieRatingsTmpl, map[string]string{"Query": slugOrID})
+}
+
+// GetShowRatings returns the community ratings of a show
+func (t *TraktTV) GetShowRatings(slugOrID string) (*Ratings, error) {
+	return t.GetShowRatingsContext(context.Background(), slugOrID)
+}
+
+// GetShowRatingsContext is GetShowRatings with a context that can cancel
+// the request
+func (t *TraktTV) GetShowRatingsContext(ctx context.Context, slugOrID string) (*Ratings, error) {
+	return t.ratings(ctx, ShowRatingsTmpl, map[string]string{"Query": slugOrID})
+}
+
+// GetSeasonRatings returns the community ratings of a season of a show
+func (t *TraktTV) GetSeasonRatings(slugOrID string, season int) (*Ratings, error) {
+	return t.GetSeasonRatingsContext(context.Background(), slugOrID, season)
+}
+
+// GetSeasonRatingsContext is GetSeasonRatings with a context that can
+// cancel the request
+func (t *TraktTV) GetSeasonRatingsContext(ctx context.Context, slugOrID string, season int) (*Ratings, error) {
+	return t.ratings(ctx, SeasonRatingsTmpl, map[string]string{"Query": slugOrID, "Season": strconv.Itoa(season)})
+}
+
+func (t *TraktTV) ratings(ctx context.Context, tmpl *template.Template, args map[string]string) (*Ratings, error) {
+	res := &Ratings{}
+	apiURL, err := t.getURLFromTemplate(tmpl, args)
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
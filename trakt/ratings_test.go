@@ -0,0 +1,99 @@
+package trakt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetRatings(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sync/ratings/all/9,10" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"rated_at":"2014-09-01T09:10:11.000Z","rating":10,"type":"movie","movie":{"title":"Batman","year":1989,"ids":{"trakt":224}}},{"rated_at":"2014-09-01T09:10:11.000Z","rating":9,"type":"season","show":{"title":"Breaking Bad","ids":{"trakt":1}},"season":{"number":1,"ids":{"trakt":1}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	items, _, err := trakt.GetRatings(RatingsOptions{Ratings: []int{9, 10}})
+	if err != nil {
+		t.Fatalf("Error getting ratings: %s", err)
+	}
+	if len(items) != 2 || items[0].Rating != 10 || items[0].Movie.Title != "Batman" || items[1].Season.Number != 1 {
+		t.Fatalf("Unexpected ratings: %#v", items)
+	}
+
+	_, _, err = trakt.GetRatings(RatingsOptions{Ratings: []int{11}})
+	if !errors.Is(err, ErrInvalidRating) {
+		t.Fatalf("Expected ErrInvalidRating, got %#v", err)
+	}
+}
+
+func TestAddRatings(t *testing.T) {
+	var posted map[string][]map[string]interface{}
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.Method != "POST" || r.URL.Path != "/sync/ratings" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"added":{"movies":1,"shows":0,"seasons":0,"episodes":1}}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	rated := time.Date(2014, 9, 1, 9, 10, 11, 0, time.UTC)
+	items := (&SyncItems{}).
+		RateMovie(&Movie{IDs: IDs{Trakt: 224}}, 10, rated).
+		RateShowEpisode(IDs{Tvdb: 81189}, 1, 1, 8, time.Time{})
+	res, err := trakt.AddRatings(items)
+	if err != nil {
+		t.Fatalf("Error adding ratings: %s", err)
+	}
+	if res.Added.Movies != 1 || res.Added.Episodes != 1 {
+		t.Fatalf("Unexpected counts: %#v", res.Added)
+	}
+	if posted["movies"][0]["rating"] != float64(10) || posted["movies"][0]["rated_at"] != "2014-09-01T09:10:11Z" {
+		t.Fatalf("Unexpected movie posted: %#v", posted["movies"])
+	}
+
+	_, err = trakt.AddRatings((&SyncItems{}).RateMovie(&Movie{IDs: IDs{Trakt: 224}}, 0, time.Time{}))
+	if !errors.Is(err, ErrInvalidRating) {
+		t.Fatalf("Expected ErrInvalidRating, got %#v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected an invalid rating not to be sent, got %d calls", calls)
+	}
+}
+
+func TestGetMovieRatings(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/movies/batman-1989/ratings" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `{"rating":7.33778,"votes":7866,"distribution":{"1":298,"2":46,"3":87,"4":178,"5":446,"6":1167,"7":1855,"8":1543,"9":662,"10":1583}}`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	r, err := trakt.GetMovieRatings("batman-1989")
+	if err != nil {
+		t.Fatalf("Error getting ratings: %s", err)
+	}
+	if r.Votes != 7866 || r.Rating < 7.3 || r.Distribution[10] != 1583 || len(r.Distribution) != 10 {
+		t.Fatalf("Unexpected ratings: %#v", r)
+	}
+}
@@ -0,0 +1,172 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"text/template"
+)
+
+// https://trakt.docs.apiary.io/#reference/movies/related
+// https://trakt.docs.apiary.io/#reference/shows/related
+var RelatedTmpl = template.Must(
+	template.New("Related").Parse("{{.Host}}/{{.Kind}}/{{.Query | urlquery}}/related"),
+)
+
+// https://trakt.docs.apiary.io/#reference/recommendations
+var RecommendationsTmpl = template.Must(
+	template.New("Recommendations").Parse("{{.Host}}/recommendations/{{.Kind}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/recommendations/hide-movie
+var HideRecommendationTmpl = template.Must(
+	template.New("HideRecommendation").Parse("{{.Host}}/recommendations/{{.Kind}}/{{.Query | urlquery}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/users/remove-hidden-items
+var UnhideRecommendationsTmpl = template.Must(
+	template.New("UnhideRecommendations").Parse("{{.Host}}/users/hidden/recommendations/remove"),
+)
+
+// RecommendationOptions filters the user's recommendations.  Zero values
+// are left to Trakt's defaults.
+type RecommendationOptions struct {
+	// IgnoreCollected leaves out items the user has collected
+	IgnoreCollected bool
+	// IgnoreWatchlisted leaves out items on the user's watchlist
+	IgnoreWatchlisted bool
+	// Limit is how many to return, up to 100
+	Limit int
+}
+
+// RelatedMovies returns the movies most like the given one along with the
+// pagination Trakt returned
+func (t *TraktTV) RelatedMovies(slugOrID string, opts PageOptions, callOpts ...CallOption) ([]Movie, *Pagination, error) {
+	return t.RelatedMoviesContext(context.Background(), slugOrID, opts, callOpts...)
+}
+
+// RelatedMoviesContext is RelatedMovies with a context that can cancel the
+// request
+func (t *TraktTV) RelatedMoviesContext(ctx context.Context, slugOrID string, opts PageOptions, callOpts ...CallOption) ([]Movie, *Pagination, error) {
+	res := []Movie{}
+	p, err := t.related(ctx, "movies", slugOrID, opts, callOpts, &res)
+	return res, p, err
+}
+
+// RelatedShows returns the shows most like the given one along with the
+// pagination Trakt returned
+func (t *TraktTV) RelatedShows(slugOrID string, opts PageOptions, callOpts ...CallOption) ([]Show, *Pagination, error) {
+	return t.RelatedShowsContext(context.Background(), slugOrID, opts, callOpts...)
+}
+
+// RelatedShowsContext is RelatedShows with a context that can cancel the
+// request
+func (t *TraktTV) RelatedShowsContext(ctx context.Context, slugOrID string, opts PageOptions, callOpts ...CallOption) ([]Show, *Pagination, error) {
+	res := []Show{}
+	p, err := t.related(ctx, "shows", slugOrID, opts, callOpts, &res)
+	return res, p, err
+}
+
+// related gets the movies or shows related to an item
+func (t *TraktTV) related(ctx context.Context, kind, slugOrID string, opts PageOptions, callOpts []CallOption, result interface{}) (*Pagination, error) {
+	args := map[string]string{
+		"Kind":  kind,
+		"Query": slugOrID,
+	}
+	params := url.Values{"extended": {extendedArg(callOpts)}}
+	pageParams(params, opts.Page, opts.Limit)
+	apiURL, err := t.getURLWithQuery(RelatedTmpl, args, params)
+	if err != nil {
+		return nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, result)
+	return parsePagination(h), err
+}
+
+// RecommendedMovies returns the movies Trakt recommends to the user
+func (t *TraktTV) RecommendedMovies(opts RecommendationOptions, callOpts ...CallOption) ([]Movie, error) {
+	return t.RecommendedMoviesContext(context.Background(), opts, callOpts...)
+}
+
+// RecommendedMoviesContext is RecommendedMovies with a context that can
+// cancel the request
+func (t *TraktTV) RecommendedMoviesContext(ctx context.Context, opts RecommendationOptions, callOpts ...CallOption) ([]Movie, error) {
+	res := []Movie{}
+	err := t.recommendations(ctx, "movies", opts, callOpts, &res)
+	return res, err
+}
+
+// RecommendedShows returns the shows Trakt recommends to the user
+func (t *TraktTV) RecommendedShows(opts RecommendationOptions, callOpts ...CallOption) ([]Show, error) {
+	return t.RecommendedShowsContext(context.Background(), opts, callOpts...)
+}
+
+// RecommendedShowsContext is RecommendedShows with a context that can
+// cancel the request
+func (t *TraktTV) RecommendedShowsContext(ctx context.Context, opts RecommendationOptions, callOpts ...CallOption) ([]Show, error) {
+	res := []Show{}
+	err := t.recommendations(ctx, "shows", opts, callOpts, &res)
+	return res, err
+}
+
+// recommendations gets the user's movie or show recommendations
+func (t *TraktTV) recommendations(ctx context.Context, kind string, opts RecommendationOptions, callOpts []CallOption, result interface{}) error {
+	params := url.Values{
+		"ignore_collected":   {strconv.FormatBool(opts.IgnoreCollected)},
+		"ignore_watchlisted": {strconv.FormatBool(opts.IgnoreWatchlisted)},
+		"extended":           {extendedArg(callOpts)},
+	}
+	pageParams(params, 0, opts.Limit)
+	apiURL, err := t.getURLWithQuery(RecommendationsTmpl, map[string]string{"Kind": kind}, params)
+	if err != nil {
+		return err
+	}
+	_, err = t.getAuthenticated(ctx, apiURL, result)
+	return err
+}
+
+// HideRecommendedMovie stops a movie from being recommended to the user
+func (t *TraktTV) HideRecommendedMovie(slugOrID string) error {
+	return t.HideRecommendedMovieContext(context.Background(), slugOrID)
+}
+
+// HideRecommendedMovieContext is HideRecommendedMovie with a context that
+// can cancel the request
+func (t *TraktTV) HideRecommendedMovieContext(ctx context.Context, slugOrID string) error {
+	return t.hideRecommendation(ctx, "movies", slugOrID)
+}
+
+// HideRecommendedShow stops a show from being recommended to the user
+func (t *TraktTV) HideRecommendedShow(slugOrID string) error {
+	return t.HideRecommendedShowContext(context.Background(), slugOrID)
+}
+
+// HideRecommendedShowContext is HideRecommendedShow with a context that
+// can cancel the request
+func (t *TraktTV) HideRecommendedShowContext(ctx context.Context, slugOrID string) error {
+	return t.hideRecommendation(ctx, "shows", slugOrID)
+}
+
+// hideRecommendation hides a movie or show from the recommendations
+func (t *TraktTV) hideRecommendation(ctx context.Context, kind, slugOrID string) error {
+	apiURL, err := t.getURLFromTemplate(HideRecommendationTmpl, map[string]string{
+		"Kind":  kind,
+		"Query": slugOrID,
+	})
+	if err != nil {
+		return err
+	}
+	return t.sendAuthenticated(ctx, "DELETE", apiURL, nil, nil)
+}
+
+// UnhideRecommendations lets hidden movies and shows be recommended again.
+// The result's Deleted counts say how many were unhidden.
+func (t *TraktTV) UnhideRecommendations(items *SyncItems) (*SyncResult, error) {
+	return t.UnhideRecommendationsContext(context.Background(), items)
+}
+
+// UnhideRecommendationsContext is UnhideRecommendations with a context
+// that can cancel the request
+func (t *TraktTV) UnhideRecommendationsContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, UnhideRecommendationsTmpl, items)
+}
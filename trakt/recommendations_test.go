@@ -0,0 +1,79 @@
+package trakt
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRelatedShows(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/shows/battlestar-galactica-2003/related" || r.URL.RawQuery != "extended=full&limit=5" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"title":"Caprica","year":2010,"ids":{"trakt":4,"slug":"caprica"},"network":"Syfy"}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, _, err := trakt.RelatedShows("battlestar-galactica-2003", PageOptions{Limit: 5}, ExtendedInfo(ExtendedFull))
+	if err != nil {
+		t.Fatalf("Error getting related shows: %s", err)
+	}
+	if len(res) != 1 || res[0].Title != "Caprica" || res[0].Network != "Syfy" {
+		t.Fatalf("Unexpected related shows: %#v", res)
+	}
+}
+
+func TestRecommendedMovies(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer access1" {
+					t.Errorf("Expected the user's token, got %q", r.Header.Get("Authorization"))
+				}
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/recommendations/movies":
+					if r.URL.RawQuery != "ignore_collected=true&ignore_watchlisted=false&limit=10" {
+						t.Errorf("Unexpected request: %s", r.URL)
+					}
+					fmt.Fprintln(w, `[{"title":"The Dark Knight","year":2008,"ids":{"trakt":120}}]`)
+				case r.Method == "DELETE" && r.URL.Path == "/recommendations/movies/120":
+					w.WriteHeader(http.StatusNoContent)
+				case r.Method == "POST" && r.URL.Path == "/users/hidden/recommendations/remove":
+					body, _ := io.ReadAll(r.Body)
+					if strings.TrimSpace(string(body)) != `{"movies":[{"ids":{"trakt":120}}]}` {
+						t.Errorf("Unexpected unhide body: %s", body)
+					}
+					fmt.Fprintln(w, `{"deleted":{"movies":1,"shows":0},"not_found":{"movies":[],"shows":[]}}`)
+				default:
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	res, err := trakt.RecommendedMovies(RecommendationOptions{IgnoreCollected: true, Limit: 10})
+	if err != nil {
+		t.Fatalf("Error getting recommendations: %s", err)
+	}
+	if len(res) != 1 || res[0].Title != "The Dark Knight" {
+		t.Fatalf("Unexpected recommendations: %#v", res)
+	}
+	if err := trakt.HideRecommendedMovie("120"); err != nil {
+		t.Fatalf("Error hiding recommendation: %s", err)
+	}
+	result, err := trakt.UnhideRecommendations(new(SyncItems).AddMovie(&Movie{IDs: IDs{Trakt: 120}}, time.Time{}))
+	if err != nil {
+		t.Fatalf("Error unhiding recommendation: %s", err)
+	}
+	if result.Deleted.Movies != 1 {
+		t.Fatalf("Expected 1 movie unhidden, got %#v", result.Deleted)
+	}
+}
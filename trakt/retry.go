@@ -0,0 +1,143 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how GET requests that fail for transient reasons
+// are retried.  Only GETs are retried since they're idempotent; 429
+// responses are handled by the rate limiter instead.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	// Values below 2 disable retrying.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry.  It doubles after
+	// every attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Jitter is the fraction, between 0 and 1, of each backoff that is
+	// randomized so many clients don't retry in lockstep.
+	Jitter float64
+	// RetryableStatus lists the HTTP status codes worth retrying.
+	RetryableStatus []int
+	// RetryableError decides if a transport error is worth retrying.  If
+	// nil, timeouts, refused or reset connections and truncated responses
+	// are retried.
+	RetryableError func(error) bool
+}
+
+// DefaultRetryPolicy retries server errors and network failures a few times
+// with exponential backoff.  New doesn't retry unless given the Retry
+// option.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+	Jitter:      0.5,
+	RetryableStatus: []int{
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		520, 521, 522, // Cloudflare errors Trakt returns when it is down
+	},
+}
+
+// Retry sets the policy used to retry failed GET requests
+func Retry(p RetryPolicy) option {
+	return func(t *TraktTV) {
+		t.retryPolicy = p
+	}
+}
+
+// RetryError is returned when a request still failed after being retried.
+// It wraps the error from the last attempt, and the context's error too if
+// the context ended while waiting to retry.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("trakt: giving up after %d attempts: %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// retryable reports if err is a transient failure the policy says to retry
+func (p RetryPolicy) retryable(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		for _, code := range p.RetryableStatus {
+			if httpErr.StatusCode == code {
+				return true
+			}
+		}
+		return false
+	}
+	if p.RetryableError != nil {
+		return p.RetryableError(err)
+	}
+	return transientError(err)
+}
+
+// transientError is the default RetryableError
+func transientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// backoff returns how long to wait before the given retry, counting from 1
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(p.Jitter * rand.Float64() * float64(d))
+	}
+	return d
+}
+
+// withRetries calls fn until it succeeds, fails with an error the policy
+// doesn't retry, runs out of attempts or ctx is done.
+func (p RetryPolicy) withRetries(ctx context.Context, fn func() error) error {
+	err := fn()
+	attempt := 1
+	for ; err != nil && attempt < p.MaxAttempts && p.retryable(err); attempt++ {
+		if serr := sleepContext(ctx, p.backoff(attempt)); serr != nil {
+			return &RetryError{Attempts: attempt, Err: errors.Join(serr, err)}
+		}
+		err = fn()
+	}
+	if err != nil && attempt > 1 {
+		return &RetryError{Attempts: attempt, Err: err}
+	}
+	return err
+}
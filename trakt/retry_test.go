@@ -0,0 +1,151 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:     3,
+	BaseBackoff:     time.Millisecond,
+	MaxBackoff:      5 * time.Millisecond,
+	Jitter:          0.5,
+	RetryableStatus: DefaultRetryPolicy.RetryableStatus,
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls < 3 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), Retry(testRetryPolicy))
+	_, err := trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Expected request to succeed after retries, got %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), Retry(testRetryPolicy))
+	_, err := trakt.GetMovie("batman-1989")
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Fatalf("Expected a RetryError after 3 attempts, got %#v", err)
+	}
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the last HTTPError to be wrapped, got %#v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetrySkipsPermanentErrors(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				http.NotFound(w, r)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), Retry(testRetryPolicy))
+	_, err := trakt.GetMovie("no-such-movie")
+	if _, ok := err.(*HTTPError); !ok || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected a plain 404 HTTPError, got %#v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetryOnlyGets(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), Retry(testRetryPolicy))
+	_, err := trakt.DeviceCode()
+	if err == nil {
+		t.Fatal("Expected an error and got none")
+	}
+	if calls != 1 {
+		t.Fatalf("Expected POST not to be retried, got %d calls", calls)
+	}
+}
+
+func TestRetryContextDeadline(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+	defer ts.Close()
+
+	policy := testRetryPolicy
+	policy.MaxAttempts = 10
+	policy.BaseBackoff = 100 * time.Millisecond
+	policy.MaxBackoff = 100 * time.Millisecond
+	policy.Jitter = 0
+	trakt, _ := New("testing", Host(ts.URL), Retry(policy))
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+	_, err := trakt.ShowSearchContext(ctx, "battlestar")
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts < 2 {
+		t.Fatalf("Expected a RetryError with the attempts made, got %#v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the context error to be kept, got %v", err)
+	}
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the last HTTPError to be kept, got %v", err)
+	}
+}
+
+func TestRetryConnectionErrors(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	url := ts.URL
+	ts.Close()
+
+	trakt, _ := New("testing", Host(url), Retry(testRetryPolicy))
+	_, err := trakt.ShowSearch("battlestar")
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Fatalf("Expected connection errors to be retried 3 times, got %#v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package trakt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/scrobble
+var ScrobbleTmpl = template.Must(
+	template.New("Scrobble").Parse("{{.Host}}/scrobble/{{.Action}}"),
+)
+
+// Media is a movie or episode that can be scrobbled or checked into: a
+// *Movie, an *Episode with its IDs, or an episode given by its show with
+// ShowEpisode.
+type Media interface {
+	mediaBody() mediaBody
+}
+
+// mediaBody is how a Media is identified in scrobble and checkin requests
+type mediaBody struct {
+	Movie   *SyncItem     `json:"movie,omitempty"`
+	Show    *SyncItem     `json:"show,omitempty"`
+	Episode *mediaEpisode `json:"episode,omitempty"`
+}
+
+type mediaEpisode struct {
+	IDs    *IDs `json:"ids,omitempty"`
+	Season int  `json:"season,omitempty"`
+	Number int  `json:"number,omitempty"`
+}
+
+func (m *Movie) mediaBody() mediaBody {
+	return mediaBody{Movie: &SyncItem{IDs: m.IDs}}
+}
+
+func (e *Episode) mediaBody() mediaBody {
+	ids := e.IDs
+	return mediaBody{Episode: &mediaEpisode{IDs: &ids}}
+}
+
+type showEpisode struct {
+	show            IDs
+	season, episode int
+}
+
+func (s showEpisode) mediaBody() mediaBody {
+	return mediaBody{
+		Show:    &SyncItem{IDs: s.show},
+		Episode: &mediaEpisode{Season: s.season, Number: s.episode},
+	}
+}
+
+// ShowEpisode identifies an episode by its show's IDs and its season and
+// episode numbers, for when the episode's own IDs aren't known.
+func ShowEpisode(show IDs, season, episode int) Media {
+	return showEpisode{show: show, season: season, episode: episode}
+}
+
+// ScrobbleResult is Trakt's answer to a scrobble.  When the item was
+// already scrobbled in the last few minutes Trakt answers with a 409,
+// which is reported as AlreadyScrobbled rather than as an error.
+type ScrobbleResult struct {
+	ID       int64    `json:"id"`
+	Action   string   `json:"action"`
+	Progress float64  `json:"progress"`
+	Movie    *Movie   `json:"movie"`
+	Show     *Show    `json:"show"`
+	Episode  *Episode `json:"episode"`
+
+	AlreadyScrobbled bool `json:"-"`
+	// WatchedAt and ExpiresAt are only set when AlreadyScrobbled is.
+	// Scrobbling the item again before ExpiresAt will keep failing.
+	WatchedAt time.Time `json:"watched_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Scrobbler reports what a media player is playing to Trakt
+type Scrobbler struct {
+	t *TraktTV
+	// AppVersion and AppDate optionally identify the player
+	AppVersion string
+	AppDate    string
+}
+
+// NewScrobbler returns a Scrobbler using t, which must have a user's token
+func NewScrobbler(t *TraktTV) *Scrobbler {
+	return &Scrobbler{t: t}
+}
+
+// Start tells Trakt the media started playing, or is still playing, at the
+// given progress percentage.
+func (s *Scrobbler) Start(media Media, progress float64) (*ScrobbleResult, error) {
+	return s.StartContext(context.Background(), media, progress)
+}
+
+// StartContext is Start with a context that can cancel the request
+func (s *Scrobbler) StartContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return s.scrobble(ctx, "start", media, progress)
+}
+
+// Pause tells Trakt playback was paused at the given progress percentage
+func (s *Scrobbler) Pause(media Media, progress float64) (*ScrobbleResult, error) {
+	return s.PauseContext(context.Background(), media, progress)
+}
+
+// PauseContext is Pause with a context that can cancel the request
+func (s *Scrobbler) PauseContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return s.scrobble(ctx, "pause", media, progress)
+}
+
+// Stop tells Trakt playback stopped at the given progress percentage.
+// Above 80% Trakt marks the media watched, below it saves the progress as
+// paused.
+func (s *Scrobbler) Stop(media Media, progress float64) (*ScrobbleResult, error) {
+	return s.StopContext(context.Background(), media, progress)
+}
+
+// StopContext is Stop with a context that can cancel the request
+func (s *Scrobbler) StopContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return s.scrobble(ctx, "stop", media, progress)
+}
+
+func (s *Scrobbler) scrobble(ctx context.Context, action string, media Media, progress float64) (*ScrobbleResult, error) {
+	res := &ScrobbleResult{}
+	apiURL, err := s.t.getURLFromTemplate(ScrobbleTmpl, map[string]string{"Action": action})
+	if err != nil {
+		return res, err
+	}
+	payload := struct {
+		mediaBody
+		Progress   float64 `json:"progress"`
+		AppVersion string  `json:"app_version,omitempty"`
+		AppDate    string  `json:"app_date,omitempty"`
+	}{media.mediaBody(), progress, s.AppVersion, s.AppDate}
+	err = s.t.sendAuthenticated(ctx, "POST", apiURL, payload, res)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict {
+		res.AlreadyScrobbled = true
+		res.Action = action
+		// The body only has the times, so a failure here isn't an error
+		json.Unmarshal([]byte(httpErr.Body), res)
+		return res, nil
+	}
+	return res, err
+}
+
+// Debouncer sits between a media player and a Scrobbler, dropping the
+// progress updates players send every few seconds so Trakt only hears
+// about changes.  A Start for what's already playing is only sent once
+// Interval has passed since the last one, a repeated Pause is dropped, and
+// Stop is always sent.  It's safe to use from multiple goroutines.
+type Debouncer struct {
+	Scrobbler *Scrobbler
+	Interval  time.Duration
+
+	mu         sync.Mutex
+	lastMedia  mediaBody
+	lastAction string
+	lastSent   time.Time
+}
+
+// NewDebouncer returns a Debouncer sending Starts for the same media at
+// most once per interval.
+func NewDebouncer(s *Scrobbler, interval time.Duration) *Debouncer {
+	return &Debouncer{Scrobbler: s, Interval: interval}
+}
+
+// Start is Scrobbler.Start, returning a nil result when the update was
+// dropped.
+func (d *Debouncer) Start(media Media, progress float64) (*ScrobbleResult, error) {
+	return d.StartContext(context.Background(), media, progress)
+}
+
+// StartContext is Start with a context that can cancel the request
+func (d *Debouncer) StartContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return d.scrobble(ctx, "start", media, progress)
+}
+
+// Pause is Scrobbler.Pause, returning a nil result when the update was
+// dropped.
+func (d *Debouncer) Pause(media Media, progress float64) (*ScrobbleResult, error) {
+	return d.PauseContext(context.Background(), media, progress)
+}
+
+// PauseContext is Pause with a context that can cancel the request
+func (d *Debouncer) PauseContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return d.scrobble(ctx, "pause", media, progress)
+}
+
+// Stop is Scrobbler.Stop
+func (d *Debouncer) Stop(media Media, progress float64) (*ScrobbleResult, error) {
+	return d.StopContext(context.Background(), media, progress)
+}
+
+// StopContext is Stop with a context that can cancel the request
+func (d *Debouncer) StopContext(ctx context.Context, media Media, progress float64) (*ScrobbleResult, error) {
+	return d.scrobble(ctx, "stop", media, progress)
+}
+
+func (d *Debouncer) scrobble(ctx context.Context, action string, media Media, progress float64) (*ScrobbleResult, error) {
+	body := media.mediaBody()
+	d.mu.Lock()
+	now := time.Now()
+	same := d.lastAction == action && sameMedia(d.lastMedia, body)
+	if same && (action == "pause" || (action == "start" && now.Sub(d.lastSent) < d.Interval)) {
+		d.mu.Unlock()
+		return nil, nil
+	}
+	d.lastMedia, d.lastAction, d.lastSent = body, action, now
+	d.mu.Unlock()
+
+	res, err := d.Scrobbler.scrobble(ctx, action, media, progress)
+	if err != nil {
+		// Let the next update through rather than dropping it
+		d.mu.Lock()
+		d.lastAction = ""
+		d.mu.Unlock()
+	}
+	return res, err
+}
+
+func sameMedia(a, b mediaBody) bool {
+	ja, _ := json.Marshal(a)
+	jb, _ := json.Marshal(b)
+	return string(ja) == string(jb)
+}
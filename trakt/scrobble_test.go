@@ -0,0 +1,120 @@
+package trakt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScrobble(t *testing.T) {
+	var posted map[string]interface{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/scrobble/start" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"id":0,"action":"start","progress":1.25,"sharing":{"twitter":false},"movie":{"title":"Batman","year":1989,"ids":{"trakt":224}}}`)
+			}))
+	defer ts.Close()
+
+	s := NewScrobbler(authedClient(ts.URL))
+	s.AppVersion = "1.0"
+	res, err := s.Start(&Movie{IDs: IDs{Trakt: 224}}, 1.25)
+	if err != nil {
+		t.Fatalf("Error scrobbling: %s", err)
+	}
+	if res.Action != "start" || res.Movie == nil || res.Movie.Title != "Batman" || res.AlreadyScrobbled {
+		t.Fatalf("Unexpected result: %#v", res)
+	}
+	movie := posted["movie"].(map[string]interface{})
+	if movie["ids"].(map[string]interface{})["trakt"] != float64(224) || posted["progress"] != 1.25 || posted["app_version"] != "1.0" {
+		t.Fatalf("Unexpected body posted: %#v", posted)
+	}
+	if _, ok := posted["episode"]; ok {
+		t.Fatalf("Expected only the movie to be posted: %#v", posted)
+	}
+}
+
+func TestScrobbleShowEpisode(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				body = string(b)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"id":1,"action":"stop","progress":99.9}`)
+			}))
+	defer ts.Close()
+
+	s := NewScrobbler(authedClient(ts.URL))
+	_, err := s.Stop(ShowEpisode(IDs{Tvdb: 73545}, 1, 2), 99.9)
+	if err != nil {
+		t.Fatalf("Error scrobbling: %s", err)
+	}
+	want := `{"show":{"ids":{"tvdb":73545}},"episode":{"season":1,"number":2},"progress":99.9}`
+	if body != want {
+		t.Fatalf("Unexpected body posted:\n%s\nwanted:\n%s", body, want)
+	}
+}
+
+func TestScrobbleAlreadyScrobbled(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintln(w, `{"watched_at":"2014-10-15T22:21:29.000Z","expires_at":"2014-10-15T23:21:29.000Z"}`)
+			}))
+	defer ts.Close()
+
+	s := NewScrobbler(authedClient(ts.URL))
+	res, err := s.Stop(&Episode{IDs: IDs{Trakt: 16}}, 90)
+	if err != nil {
+		t.Fatalf("Expected a 409 not to be an error, got %s", err)
+	}
+	expires := time.Date(2014, 10, 15, 23, 21, 29, 0, time.UTC)
+	if !res.AlreadyScrobbled || !res.ExpiresAt.Equal(expires) || res.Action != "stop" {
+		t.Fatalf("Unexpected result: %#v", res)
+	}
+}
+
+func TestDebouncer(t *testing.T) {
+	actions := []string{}
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				actions = append(actions, strings.TrimPrefix(r.URL.Path, "/scrobble/"))
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{}`)
+			}))
+	defer ts.Close()
+
+	d := NewDebouncer(NewScrobbler(authedClient(ts.URL)), time.Hour)
+	movie := &Movie{IDs: IDs{Trakt: 224}}
+	other := &Movie{IDs: IDs{Trakt: 225}}
+	d.Start(movie, 1)
+	d.Start(movie, 2)
+	d.Start(movie, 3)
+	d.Pause(movie, 4)
+	d.Pause(movie, 4)
+	d.Start(movie, 5)
+	d.Start(other, 1)
+	res, _ := d.Start(other, 2)
+	if res != nil {
+		t.Fatalf("Expected a nil result for a dropped update, got %#v", res)
+	}
+	d.Stop(other, 90)
+
+	want := "start,pause,start,start,stop"
+	if strings.Join(actions, ",") != want {
+		t.Fatalf("Expected %s to be sent, got %s", want, strings.Join(actions, ","))
+	}
+}
@@ -0,0 +1,177 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// https://trakt.docs.apiary.io/#reference/search/text-query
+//
+// Types is the comma separated list of types, each already path escaped.
+var SearchTmpl = template.Must(
+	template.New("Search").Parse("{{.Host}}/search/{{.Types}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/search/id-lookup
+var IDLookupTmpl = template.Must(
+	template.New("IDLookup").Parse("{{.Host}}/search/{{.IDType | urlquery}}/{{.ID | urlquery}}"),
+)
+
+// Types of item Search can return
+const (
+	SearchMovie   = "movie"
+	SearchShow    = "show"
+	SearchEpisode = "episode"
+	SearchPerson  = "person"
+	SearchList    = "list"
+)
+
+// Kinds of ID LookupID can resolve
+const (
+	IDTrakt = "trakt"
+	IDImdb  = "imdb"
+	IDTmdb  = "tmdb"
+	IDTvdb  = "tvdb"
+	// IDSlug looks up movies, shows and people by their Trakt slug, i.e.
+	// "tron-legacy-2010"
+	IDSlug = "slug"
+)
+
+// SearchOptions filters and pages the results of Search.  Zero values are
+// left out of the request.
+type SearchOptions struct {
+	// Types are the Search constants for the types of item to search
+	// for, all of them if empty.
+	Types []string
+	// Fields restricts which fields are matched, i.e. "title",
+	// "overview" or "aliases".  Which fields can be used depends on the
+	// types searched.
+	Fields []string
+	// StartYear limits the results to items from that year, or with
+	// EndYear to items from the years between them.
+	StartYear int
+	EndYear   int
+	Page      int
+	Limit     int
+}
+
+// years formats the year filter
+func (o SearchOptions) years() string {
+	switch {
+	case o.StartYear == 0:
+		return ""
+	case o.EndYear == 0:
+		return strconv.Itoa(o.StartYear)
+	default:
+		return strconv.Itoa(o.StartYear) + "-" + strconv.Itoa(o.EndYear)
+	}
+}
+
+// Search searches for movies, shows, episodes, people and lists, returning
+// the best matches first along with the pagination Trakt returned.
+func (t *TraktTV) Search(query string, opts SearchOptions, callOpts ...CallOption) ([]SearchResult, *Pagination, error) {
+	return t.SearchContext(context.Background(), query, opts, callOpts...)
+}
+
+// SearchContext is Search with a context that can cancel the request
+func (t *TraktTV) SearchContext(ctx context.Context, query string, opts SearchOptions, callOpts ...CallOption) ([]SearchResult, *Pagination, error) {
+	types := opts.Types
+	if len(types) == 0 {
+		types = []string{SearchMovie, SearchShow, SearchEpisode, SearchPerson, SearchList}
+	}
+	escaped := make([]string, len(types))
+	for i, typ := range types {
+		escaped[i] = url.PathEscape(typ)
+	}
+	params := url.Values{
+		"query":    {query},
+		"fields":   {strings.Join(opts.Fields, ",")},
+		"years":    {opts.years()},
+		"extended": {extendedArg(callOpts)},
+	}
+	pageParams(params, opts.Page, opts.Limit)
+	res := []SearchResult{}
+	apiURL, err := t.getURLWithQuery(SearchTmpl, map[string]string{"Types": strings.Join(escaped, ",")}, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getWithHeaders(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// LookupID finds the items with an external ID, i.e. LookupID(IDImdb,
+// "tt0848228", nil).  types are the Search constants for the types of item
+// wanted, all of them if empty, which matters for IDs like Trakt's that are
+// only unique within a type.  IDSlug lookups can only find movies, shows
+// and people.
+func (t *TraktTV) LookupID(idType, id string, types []string, opts ...CallOption) ([]SearchResult, error) {
+	return t.LookupIDContext(context.Background(), idType, id, types, opts...)
+}
+
+// LookupIDContext is LookupID with a context that can cancel the request
+func (t *TraktTV) LookupIDContext(ctx context.Context, idType, id string, types []string, opts ...CallOption) ([]SearchResult, error) {
+	if idType == IDSlug {
+		return t.lookupSlug(ctx, id, types, opts)
+	}
+	args := map[string]string{
+		"IDType": idType,
+		"ID":     id,
+	}
+	params := url.Values{
+		"type":     {strings.Join(types, ",")},
+		"extended": {extendedArg(opts)},
+	}
+	res := []SearchResult{}
+	apiURL, err := t.getURLWithQuery(IDLookupTmpl, args, params)
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, &res)
+	return res, err
+}
+
+// lookupSlug finds the movies, shows and people with a slug.  Trakt's ID
+// lookup doesn't take slugs, so each type's summary is fetched instead.
+func (t *TraktTV) lookupSlug(ctx context.Context, slug string, types []string, opts []CallOption) ([]SearchResult, error) {
+	if len(types) == 0 {
+		types = []string{SearchMovie, SearchShow, SearchPerson}
+	}
+	res := []SearchResult{}
+	params := url.Values{"extended": {extendedArg(opts)}}
+	for _, typ := range types {
+		r := SearchResult{Type: typ}
+		var tmpl *template.Template
+		var item interface{}
+		switch typ {
+		case SearchMovie:
+			r.Movie = &Movie{}
+			tmpl, item = MovieSummaryTmpl, r.Movie
+		case SearchShow:
+			r.Show = &Show{}
+			tmpl, item = ShowSummaryTmpl, r.Show
+		case SearchPerson:
+			r.Person = &Person{}
+			tmpl, item = PersonTmpl, r.Person
+		default:
+			return res, fmt.Errorf("trakt: can't look up a %s by slug", typ)
+		}
+		apiURL, err := t.getURLWithQuery(tmpl, map[string]string{"Query": slug}, params)
+		if err != nil {
+			return res, err
+		}
+		err = t.getWithErrorCheck(ctx, apiURL, item)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return res, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
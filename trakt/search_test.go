@@ -0,0 +1,92 @@
+package trakt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if r.URL.EscapedPath() != "/search/movie,person" || q.Get("query") != "tron" ||
+					q.Get("fields") != "title,aliases" || q.Get("years") != "1982-2010" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"type":"movie","score":26.019499,"movie":{"title":"TRON: Legacy","year":2010,"ids":{"trakt":1,"slug":"tron-legacy-2010","imdb":"tt1104001","tmdb":20526}}},{"type":"person","score":7.5,"person":{"name":"Tron Guy","ids":{"trakt":2,"slug":"tron-guy"}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, _, err := trakt.Search("tron", SearchOptions{
+		Types:     []string{SearchMovie, SearchPerson},
+		Fields:    []string{"title", "aliases"},
+		StartYear: 1982,
+		EndYear:   2010,
+	})
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(res))
+	}
+	if res[0].Type != SearchMovie || res[0].Movie == nil || res[0].Movie.Year != 2010 || res[0].Score < 26 {
+		t.Fatalf("Unexpected movie result: %#v", res[0])
+	}
+	if res[1].Type != SearchPerson || res[1].Person == nil || res[1].Person.Name != "Tron Guy" {
+		t.Fatalf("Unexpected person result: %#v", res[1])
+	}
+}
+
+func TestLookupID(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/search/tvdb/73739" || r.URL.RawQuery != "extended=full&type=episode" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"type":"episode","score":null,"episode":{"season":1,"number":1,"title":"Pilot (1)","ids":{"trakt":1,"tvdb":73739}},"show":{"title":"Lost","year":2004,"ids":{"trakt":2,"slug":"lost-2004"}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, err := trakt.LookupID(IDTvdb, "73739", []string{SearchEpisode}, ExtendedInfo(ExtendedFull))
+	if err != nil {
+		t.Fatalf("Error looking up id: %s", err)
+	}
+	if len(res) != 1 || res[0].Episode == nil || res[0].Episode.Title != "Pilot (1)" || res[0].Show.Title != "Lost" {
+		t.Fatalf("Unexpected lookup result: %#v", res)
+	}
+}
+
+func TestLookupSlug(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/movies/tron-legacy-2010":
+					fmt.Fprintln(w, `{"title":"TRON: Legacy","year":2010,"ids":{"trakt":1,"slug":"tron-legacy-2010"}}`)
+				case "/shows/tron-legacy-2010", "/people/tron-legacy-2010":
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	res, err := trakt.LookupID(IDSlug, "tron-legacy-2010", nil)
+	if err != nil {
+		t.Fatalf("Error looking up slug: %s", err)
+	}
+	if len(res) != 1 || res[0].Type != SearchMovie || res[0].Movie == nil || res[0].Movie.Year != 2010 {
+		t.Fatalf("Unexpected slug lookup result: %#v", res)
+	}
+	_, err = trakt.LookupID(IDSlug, "tron-legacy-2010", []string{SearchEpisode})
+	if err == nil {
+		t.Fatalf("Expected an error looking up an episode by slug")
+	}
+}
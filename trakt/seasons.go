@@ -0,0 +1,118 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultSeasonWorkers is how many seasons ShowSeasons fetches at once
+const DefaultSeasonWorkers = 4
+
+// SeasonWorkers sets how many seasons ShowSeasons fetches at once
+func SeasonWorkers(n int) option {
+	return func(t *TraktTV) {
+		if n < 1 {
+			n = 1
+		}
+		t.seasonWorkers = n
+	}
+}
+
+// SeasonError is the error from fetching a single season
+type SeasonError struct {
+	Season int
+	Err    error
+}
+
+// SeasonsError is returned by ShowSeasons when some seasons couldn't be
+// fetched.  Seasons that were skipped because another one failed first
+// aren't listed.
+type SeasonsError struct {
+	Failed []SeasonError
+}
+
+func (e *SeasonsError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, f := range e.Failed {
+		msgs[i] = fmt.Sprintf("season %d: %s", f.Season, f.Err)
+	}
+	return "trakt: error fetching seasons: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap lets errors.Is and errors.As look at each season's error
+func (e *SeasonsError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, f := range e.Failed {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// fetchSeasons fills in the episodes of each season using a bounded pool
+// of workers.  The first failure cancels the requests still outstanding.
+func (t *TraktTV) fetchSeasons(parent context.Context, slugOrID, extended string, seasons []Season) error {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range seasons {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	workers := t.seasonWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed = map[int]error{}
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := t.showSeason(ctx, slugOrID, extended, &seasons[i])
+				if err == nil {
+					continue
+				}
+				// Requests we cancelled ourselves aren't failures worth
+				// reporting, but the caller cancelling is.
+				if errors.Is(err, context.Canceled) && parent.Err() == nil {
+					continue
+				}
+				mu.Lock()
+				failed[i] = err
+				mu.Unlock()
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(failed) == 0 {
+		return nil
+	}
+	idx := make([]int, 0, len(failed))
+	for i := range failed {
+		idx = append(idx, i)
+	}
+	sort.Ints(idx)
+	serr := &SeasonsError{}
+	for _, i := range idx {
+		serr.Failed = append(serr.Failed, SeasonError{Season: seasons[i].Number, Err: failed[i]})
+	}
+	return serr
+}
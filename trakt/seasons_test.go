@@ -0,0 +1,87 @@
+package trakt
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShowSeasonsConcurrent(t *testing.T) {
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				inFlight++
+				if inFlight > maxSeen {
+					maxSeen = inFlight
+				}
+				mu.Unlock()
+				defer func() {
+					mu.Lock()
+					inFlight--
+					mu.Unlock()
+				}()
+
+				season := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+				// Make the earlier seasons slower so they finish last
+				if season == "1" {
+					time.Sleep(50 * time.Millisecond)
+				}
+				time.Sleep(10 * time.Millisecond)
+				fmt.Fprintf(w, `[{"season":%s,"number":1,"title":"Season %s premiere"}]`, season, season)
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), SeasonWorkers(3))
+	wanted := []int{1, 2, 3, 4, 5, 6}
+	seas, err := trakt.ShowSeasons("battlestar-galactica-2003", wanted)
+	if err != nil {
+		t.Fatalf("Error getting seasons: %s", err)
+	}
+	for i, s := range seas {
+		if s.Number != wanted[i] || len(s.Episodes) != 1 || s.Episodes[0].Season != wanted[i] {
+			t.Fatalf("Season %d out of order or missing episodes: %#v", i, s)
+		}
+	}
+	if maxSeen > 3 {
+		t.Fatalf("Expected at most 3 concurrent requests, saw %d", maxSeen)
+	}
+	if maxSeen < 2 {
+		t.Fatalf("Expected seasons to be fetched concurrently, saw %d at once", maxSeen)
+	}
+}
+
+func TestShowSeasonsFailure(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/seasons/2") {
+					http.NotFound(w, r)
+					return
+				}
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL), SeasonWorkers(1))
+	_, err := trakt.ShowSeasons("battlestar-galactica-2003", []int{1, 2, 3, 4})
+	var serr *SeasonsError
+	if !errors.As(err, &serr) {
+		t.Fatalf("Expected a SeasonsError, got %#v", err)
+	}
+	if len(serr.Failed) != 1 || serr.Failed[0].Season != 2 {
+		t.Fatalf("Expected only season 2 to be reported as failed, got %s", serr)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected the season's error to be reachable with errors.Is, got %s", err)
+	}
+}
@@ -0,0 +1,150 @@
+package trakt
+
+import (
+	"time"
+)
+
+// SyncItems is the body sent to the sync endpoints that add or remove
+// items from a user's history, collection, watchlist and ratings.  Items
+// can be identified by any of their IDs.
+type SyncItems struct {
+	Movies   []SyncItem `json:"movies,omitempty"`
+	Shows    []SyncShow `json:"shows,omitempty"`
+	Seasons  []SyncItem `json:"seasons,omitempty"`
+	Episodes []SyncItem `json:"episodes,omitempty"`
+	// People can only be added to custom lists
+	People []SyncItem `json:"people,omitempty"`
+	// IDs are history ids, only used when removing from history
+	IDs []int64 `json:"ids,omitempty"`
+}
+
+// SyncItem is a movie, show, season or episode in a SyncItems
+type SyncItem struct {
+	IDs         IDs        `json:"ids"`
+	Title       string     `json:"title,omitempty"`
+	Year        int        `json:"year,omitempty"`
+	WatchedAt   *time.Time `json:"watched_at,omitempty"`
+	CollectedAt *time.Time `json:"collected_at,omitempty"`
+	// Metadata describes the copy being collected
+	*Metadata
+	// Rating is from 1 to 10, for the ratings endpoints
+	Rating  int        `json:"rating,omitempty"`
+	RatedAt *time.Time `json:"rated_at,omitempty"`
+}
+
+// SyncShow is a show in a SyncItems.  Without Seasons the whole show is
+// synced, otherwise just the given seasons and episodes.
+type SyncShow struct {
+	SyncItem
+	Seasons []SyncShowSeason `json:"seasons,omitempty"`
+}
+
+// SyncShowSeason is a season of a SyncShow.  Without Episodes the whole
+// season is synced.
+type SyncShowSeason struct {
+	Number    int               `json:"number"`
+	WatchedAt *time.Time        `json:"watched_at,omitempty"`
+	Rating    int               `json:"rating,omitempty"`
+	RatedAt   *time.Time        `json:"rated_at,omitempty"`
+	Episodes  []SyncShowEpisode `json:"episodes,omitempty"`
+}
+
+// SyncShowEpisode is an episode of a SyncShowSeason
+type SyncShowEpisode struct {
+	Number      int        `json:"number"`
+	WatchedAt   *time.Time `json:"watched_at,omitempty"`
+	CollectedAt *time.Time `json:"collected_at,omitempty"`
+	*Metadata
+	Rating  int        `json:"rating,omitempty"`
+	RatedAt *time.Time `json:"rated_at,omitempty"`
+}
+
+// timeOrNil returns nil for the zero time so it's left out of requests
+func timeOrNil(at time.Time) *time.Time {
+	if at.IsZero() {
+		return nil
+	}
+	return &at
+}
+
+// AddMovie adds a movie, watched at the given time.  A zero time means now,
+// and is what to pass for endpoints like the watchlist that take no time.
+func (s *SyncItems) AddMovie(m *Movie, watchedAt time.Time) *SyncItems {
+	s.Movies = append(s.Movies, SyncItem{IDs: m.IDs, WatchedAt: timeOrNil(watchedAt)})
+	return s
+}
+
+// AddShow adds every episode of a show
+func (s *SyncItems) AddShow(show *Show, watchedAt time.Time) *SyncItems {
+	s.Shows = append(s.Shows, SyncShow{SyncItem: SyncItem{IDs: show.IDs, WatchedAt: timeOrNil(watchedAt)}})
+	return s
+}
+
+// AddSeason adds every episode of a season, identified by the season's IDs
+func (s *SyncItems) AddSeason(season *Season, watchedAt time.Time) *SyncItems {
+	s.Seasons = append(s.Seasons, SyncItem{IDs: season.IDs, WatchedAt: timeOrNil(watchedAt)})
+	return s
+}
+
+// AddEpisode adds an episode, identified by the episode's IDs
+func (s *SyncItems) AddEpisode(e *Episode, watchedAt time.Time) *SyncItems {
+	s.Episodes = append(s.Episodes, SyncItem{IDs: e.IDs, WatchedAt: timeOrNil(watchedAt)})
+	return s
+}
+
+// AddShowEpisode adds an episode by its show's IDs and its season and
+// episode numbers, for when the episode's own IDs aren't known.
+func (s *SyncItems) AddShowEpisode(showIDs IDs, season, episode int, watchedAt time.Time) *SyncItems {
+	return s.addShowEpisode(showIDs, season, SyncShowEpisode{Number: episode, WatchedAt: timeOrNil(watchedAt)})
+}
+
+// addShowEpisode adds ep under its show and season, reusing them if
+// they're already there.
+func (s *SyncItems) addShowEpisode(showIDs IDs, season int, ep SyncShowEpisode) *SyncItems {
+	for i := range s.Shows {
+		if s.Shows[i].IDs != showIDs {
+			continue
+		}
+		for j := range s.Shows[i].Seasons {
+			if s.Shows[i].Seasons[j].Number == season {
+				s.Shows[i].Seasons[j].Episodes = append(s.Shows[i].Seasons[j].Episodes, ep)
+				return s
+			}
+		}
+		s.Shows[i].Seasons = append(s.Shows[i].Seasons, SyncShowSeason{Number: season, Episodes: []SyncShowEpisode{ep}})
+		return s
+	}
+	s.Shows = append(s.Shows, SyncShow{
+		SyncItem: SyncItem{IDs: showIDs},
+		Seasons:  []SyncShowSeason{{Number: season, Episodes: []SyncShowEpisode{ep}}},
+	})
+	return s
+}
+
+// SyncCounts counts the items a sync request affected
+type SyncCounts struct {
+	Movies   int `json:"movies"`
+	Shows    int `json:"shows"`
+	Seasons  int `json:"seasons"`
+	Episodes int `json:"episodes"`
+}
+
+// SyncNotFound lists the items of a sync request Trakt couldn't find
+type SyncNotFound struct {
+	Movies   []SyncItem `json:"movies"`
+	Shows    []SyncItem `json:"shows"`
+	Seasons  []SyncItem `json:"seasons"`
+	Episodes []SyncItem `json:"episodes"`
+	People   []SyncItem `json:"people"`
+	IDs      []int64    `json:"ids"`
+}
+
+// SyncResult is Trakt's summary of what a sync request did.  Which counts
+// are filled in depends on the endpoint.
+type SyncResult struct {
+	Added    SyncCounts   `json:"added"`
+	Deleted  SyncCounts   `json:"deleted"`
+	Existing SyncCounts   `json:"existing"`
+	Updated  SyncCounts   `json:"updated"`
+	NotFound SyncNotFound `json:"not_found"`
+}
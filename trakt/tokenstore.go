@@ -0,0 +1,118 @@
+package trakt
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// TokenStore holds the OAuth token used to authenticate requests.  Token
+// returns nil without an error when no token has been stored yet, and
+// SetToken is called with nil when the token is revoked.
+//
+// Implementations must be safe to use from multiple goroutines.
+type TokenStore interface {
+	Token() (*Token, error)
+	SetToken(*Token) error
+}
+
+// MemoryTokenStore keeps the token in memory.  Useful for tests and short
+// lived programs.
+type MemoryTokenStore struct {
+	mu  sync.Mutex
+	tok *Token
+}
+
+// NewMemoryTokenStore returns a MemoryTokenStore holding tok, which may be
+// nil.
+func NewMemoryTokenStore(tok *Token) *MemoryTokenStore {
+	return &MemoryTokenStore{tok: tok}
+}
+
+// Token returns a copy of the stored token
+func (s *MemoryTokenStore) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tok == nil {
+		return nil, nil
+	}
+	tok := *s.tok
+	return &tok, nil
+}
+
+// SetToken replaces the stored token
+func (s *MemoryTokenStore) SetToken(tok *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tok = tok
+	return nil
+}
+
+// FileTokenStore keeps the token as JSON in a file so it survives restarts.
+// The file is only readable by the current user.
+type FileTokenStore struct {
+	Path string
+	mu   sync.Mutex
+}
+
+// NewFileTokenStore returns a FileTokenStore that saves to path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Token reads the token from the file.  A missing file means no token.
+func (s *FileTokenStore) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	tok := &Token{}
+	err = json.Unmarshal(b, tok)
+	if err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+// SetToken writes the token to the file, replacing it atomically so a crash
+// never leaves a half written token behind.  A nil token removes the file.
+func (s *FileTokenStore) SetToken(tok *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tok == nil {
+		err := os.Remove(s.Path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	b, err := json.MarshalIndent(tok, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.Path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
@@ -0,0 +1,480 @@
+/*
+Package trakt is a client for version 2 of the Trakt.tv API.
+
+It follows the same layout as the original gotrakt package, but instead of
+embedding the API key in every URL it authenticates each request with the
+trakt-api-version and trakt-api-key headers that v2 requires.
+
+Example usage:
+
+	t, err := trakt.New(clientID)
+	shows, err := t.ShowSearch("battlestar galactica")
+*/
+package trakt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hobeone/gotrakt/httpclient"
+)
+
+// https://trakt.docs.apiary.io/#reference/search/text-query
+var ShowSearchTmpl = template.Must(
+	template.New("ShowSearch").Parse("{{.Host}}/search/show"),
+)
+
+// https://trakt.docs.apiary.io/#reference/shows/summary
+var ShowSummaryTmpl = template.Must(
+	template.New("ShowSummary").Parse("{{.Host}}/shows/{{.Query | urlquery}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/seasons/summary
+var ShowSeasonsTmpl = template.Must(
+	template.New("ShowSeasons").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons"),
+)
+
+// https://trakt.docs.apiary.io/#reference/seasons/season
+var ShowSeasonTmpl = template.Must(
+	template.New("ShowSeason").Parse("{{.Host}}/shows/{{.Query | urlquery}}/seasons/{{.Season | urlquery}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/search/text-query
+var MovieSearchTmpl = template.Must(
+	template.New("MovieSearch").Parse("{{.Host}}/search/movie"),
+)
+
+// https://trakt.docs.apiary.io/#reference/movies/summary
+var MovieSummaryTmpl = template.Must(
+	template.New("MovieSummary").Parse("{{.Host}}/movies/{{.Query | urlquery}}"),
+)
+
+// Base URL for the TraktTV v2 api
+const TraktTVBaseURL = "https://api.trakt.tv"
+
+// APIVersion is sent in the trakt-api-version header of every request
+const APIVersion = "2"
+
+// TraktTV is the main struct used to query Trakt.tv.  Use New to create new
+// instances.  APIKey is the Client ID of your Trakt application.
+type TraktTV struct {
+	APIKey       string
+	BaseURL      string
+	AuthURL      string
+	ClientSecret string
+	RedirectURI  string
+	Tokens       TokenStore
+	Client       Doer
+
+	tokenMu          sync.Mutex
+	limiter          *rateLimiter
+	rateLimitRetries int
+	retryPolicy      RetryPolicy
+	cache            Cache
+	cacheTTLs        []endpointTTL
+	seasonWorkers    int
+}
+
+// Doer sends HTTP requests.  *http.Client satisfies it, as does anything
+// wrapping one to add instrumentation or record requests in tests.
+type Doer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+type option func(*TraktTV)
+
+// New initializes and returns a new TraktTV struct
+func New(api string, options ...option) (*TraktTV, error) {
+	t := &TraktTV{
+		APIKey:  api,
+		BaseURL: TraktTVBaseURL,
+		AuthURL: TraktTVAuthURL,
+		Client: httpclient.NewTimeoutClient(
+			httpclient.ConnectTimeout(10*time.Second),
+			httpclient.ReadWriteTimeout(10*time.Second),
+		),
+		limiter:          newRateLimiter(DefaultRateLimit, DefaultRateBurst),
+		rateLimitRetries: DefaultRateLimitRetries,
+		seasonWorkers:    DefaultSeasonWorkers,
+	}
+	for _, opt := range options {
+		opt(t)
+	}
+	return t, nil
+}
+
+// Client sets the Doer used to send requests to TraktTV
+func Client(d Doer) option {
+	return func(t *TraktTV) {
+		t.Client = d
+	}
+}
+
+// Host sets the host to use for talking to TraktTV
+// This includes the protocol, hostname, port:
+// i.e. https://api.trakt.tv:443
+func Host(host string) option {
+	return func(t *TraktTV) {
+		t.BaseURL = host
+	}
+}
+
+// headers returns the headers the v2 api expects on every request, plus the
+// Authorization header if a token is given.
+func (t *TraktTV) headers(tok *Token) http.Header {
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	h.Set("trakt-api-version", APIVersion)
+	h.Set("trakt-api-key", t.APIKey)
+	if tok != nil {
+		h.Set("Authorization", "Bearer "+tok.AccessToken)
+	}
+	return h
+}
+
+func (t *TraktTV) getWithErrorCheck(ctx context.Context, url string, result interface{}) error {
+	_, err := t.getWithHeaders(ctx, url, result)
+	return err
+}
+
+// getWithHeaders is getWithErrorCheck for callers that also need the
+// response headers, like the pagination ones.  The user's token is sent if
+// there is one, but public endpoints don't need it so a token that can't
+// be refreshed doesn't stop the request.
+func (t *TraktTV) getWithHeaders(ctx context.Context, url string, result interface{}) (http.Header, error) {
+	tok, err := t.currentToken(ctx)
+	if err != nil {
+		glog.Warningf("Sending %s without a token: %s", url, err)
+		tok = nil
+	}
+	return t.getWithToken(ctx, url, result, tok)
+}
+
+// getWithToken sends a GET, authenticated with tok if it isn't nil,
+// retrying it and using the cache as configured.
+func (t *TraktTV) getWithToken(ctx context.Context, url string, result interface{}, tok *Token) (http.Header, error) {
+	var h http.Header
+	err := t.retryPolicy.withRetries(ctx, func() error {
+		var err error
+		if t.cache != nil {
+			h, err = t.cachedGet(ctx, url, result, tok)
+		} else {
+			h, err = t.doRequest(ctx, "GET", url, nil, result, tok, t.rateLimitRetries)
+		}
+		return err
+	})
+	return h, err
+}
+
+// userToken returns the token for methods that act on the user's account,
+// or ErrNotAuthenticated if there isn't one.
+func (t *TraktTV) userToken(ctx context.Context) (*Token, error) {
+	tok, err := t.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return nil, ErrNotAuthenticated
+	}
+	return tok, nil
+}
+
+// getAuthenticated is getWithHeaders for endpoints that need the user's
+// token.
+func (t *TraktTV) getAuthenticated(ctx context.Context, url string, result interface{}) (http.Header, error) {
+	tok, err := t.userToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.getWithToken(ctx, url, result, tok)
+}
+
+// sendAuthenticated sends a POST, PUT or DELETE with the user's token.
+// These aren't idempotent, so unlike GETs they are never retried.
+func (t *TraktTV) sendAuthenticated(ctx context.Context, method, url string, payload, result interface{}) error {
+	tok, err := t.userToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = t.doRequest(ctx, method, url, payload, result, tok, t.rateLimitRetries)
+	return err
+}
+
+// doRequest sends a request, authenticated with tok if it isn't nil, and
+// decodes the JSON response into result.  The request is bound to ctx so
+// cancelling it aborts the call.  A 429 Too Many Requests is retried up to
+// rateLimitRetries times.
+func (t *TraktTV) doRequest(ctx context.Context, method, url string, payload, result interface{}, tok *Token, rateLimitRetries int) (http.Header, error) {
+	glog.Infof("%s query for %s\n", method, url)
+	var body []byte
+	if payload != nil {
+		var err error
+		body, err = json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, data, err := t.sendRateLimited(ctx, method, url, body, tok, nil, rateLimitRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		return resp.Header, newHTTPError(method, url, resp, data)
+	}
+	return resp.Header, decodeBody(url, data, result)
+}
+
+// decodeBody decodes a JSON response body into result
+func decodeBody(url string, data []byte, result interface{}) error {
+	if result == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	err := json.Unmarshal(data, result)
+	switch jerr := err.(type) {
+	case *json.SyntaxError:
+		return newDecodeError(url, data, jerr.Offset, err)
+	case *json.UnmarshalTypeError:
+		return newDecodeError(url, data, jerr.Offset, err)
+	}
+	return err
+}
+
+// sendRateLimited sends a request once the rate limiter allows it.  When
+// Trakt answers 429 Too Many Requests the whole client backs off for as long
+// as Trakt asks and the request is retried, up to retries times.
+func (t *TraktTV) sendRateLimited(ctx context.Context, method, url string, body []byte, tok *Token, extra http.Header, retries int) (*http.Response, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		err := t.limiter.wait(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, data, err := t.send(ctx, method, url, body, tok, extra)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		now := time.Now()
+		if rl, ok := parseRateLimit(resp.Header); ok && rl.Remaining == 0 {
+			t.limiter.pauseUntil(rl.Until)
+		}
+		if resp.StatusCode != http.StatusTooManyRequests || attempt >= retries {
+			return resp, data, nil
+		}
+		wait := retryAfter(resp.Header, now)
+		glog.Warningf("Rate limited by Trakt, retrying %s in %s", url, wait)
+		if t.limiter == nil {
+			err = sleepContext(ctx, wait)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		t.limiter.pauseUntil(now.Add(wait))
+	}
+}
+
+// send makes a single request, with any extra headers given, and reads the
+// whole response body
+func (t *TraktTV) send(ctx context.Context, method, url string, body []byte, tok *Token, extra http.Header) (*http.Response, []byte, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header = t.headers(tok)
+	for k, v := range extra {
+		req.Header[k] = v
+	}
+
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	return resp, data, err
+}
+
+func (t *TraktTV) getURLFromTemplate(tmpl *template.Template, args map[string]string) (string, error) {
+	args["Host"] = t.BaseURL
+	out := bytes.Buffer{}
+	err := tmpl.Execute(&out, args)
+	return out.String(), err
+}
+
+// getURLWithQuery is getURLFromTemplate for endpoints that take query
+// params.  The template only builds the path; params with empty values are
+// left out of the query string.
+func (t *TraktTV) getURLWithQuery(tmpl *template.Template, args map[string]string, params url.Values) (string, error) {
+	apiURL, err := t.getURLFromTemplate(tmpl, args)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	for k, vs := range params {
+		for _, v := range vs {
+			if v != "" {
+				q.Add(k, v)
+			}
+		}
+	}
+	if len(q) == 0 {
+		return apiURL, nil
+	}
+	// Trakt's lists of values, like extended=full,images, are comma
+	// separated, and commas don't need escaping in a query
+	return apiURL + "?" + strings.ReplaceAll(q.Encode(), "%2C", ","), nil
+}
+
+// GetShow returns a show and all of it's Seasons and Episodes.  The show,
+// seasons and episodes are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetShow(slugOrID string, opts ...CallOption) (*Show, error) {
+	return t.GetShowContext(context.Background(), slugOrID, opts...)
+}
+
+// GetShowContext is GetShow with a context that can cancel the request
+func (t *TraktTV) GetShowContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Show, error) {
+	extended := extendedArg(opts, ExtendedFull)
+	args := map[string]string{"Query": slugOrID}
+
+	result := &Show{}
+	apiURL, err := t.getURLWithQuery(ShowSummaryTmpl, args, url.Values{"extended": {extended}})
+	if err != nil {
+		return result, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, result)
+	if err != nil {
+		return result, err
+	}
+
+	// The seasons need episodes to fill in Season.Episodes
+	apiURL, err = t.getURLWithQuery(ShowSeasonsTmpl, args, url.Values{"extended": {withEpisodes(extended)}})
+	if err != nil {
+		return result, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, &result.Seasons)
+	return result, err
+}
+
+// ShowSearch searches tv shows
+func (t *TraktTV) ShowSearch(name string, opts ...CallOption) ([]Show, error) {
+	return t.ShowSearchContext(context.Background(), name, opts...)
+}
+
+// ShowSearchContext is ShowSearch with a context that can cancel the request
+func (t *TraktTV) ShowSearchContext(ctx context.Context, name string, opts ...CallOption) ([]Show, error) {
+	params := url.Values{
+		"query":    {name},
+		"extended": {extendedArg(opts)},
+	}
+	result := []Show{}
+	apiURL, err := t.getURLWithQuery(ShowSearchTmpl, map[string]string{}, params)
+	if err != nil {
+		return result, err
+	}
+	hits := []SearchResult{}
+	err = t.getWithErrorCheck(ctx, apiURL, &hits)
+	for _, h := range hits {
+		if h.Show != nil {
+			result = append(result, *h.Show)
+		}
+	}
+	return result, err
+}
+
+// ShowSeasons gets a shows episode summaries by season for the given set of
+// seasons.  Seasons are fetched concurrently, see SeasonWorkers, and
+// returned in the order they were asked for.  Episodes are ExtendedFull
+// unless ExtendedInfo says otherwise.
+func (t *TraktTV) ShowSeasons(slugOrID string, seasons []int, opts ...CallOption) ([]Season, error) {
+	return t.ShowSeasonsContext(context.Background(), slugOrID, seasons, opts...)
+}
+
+// ShowSeasonsContext is ShowSeasons with a context that can cancel the
+// requests
+func (t *TraktTV) ShowSeasonsContext(ctx context.Context, slugOrID string, seasons []int, opts ...CallOption) ([]Season, error) {
+	results := make([]Season, len(seasons))
+	if len(seasons) == 0 {
+		return results, ErrNoSeasons
+	}
+	for i, season := range seasons {
+		results[i] = Season{
+			Number:   season,
+			Episodes: []Episode{},
+		}
+	}
+	err := t.fetchSeasons(ctx, slugOrID, extendedArg(opts, ExtendedFull), results)
+	return results, err
+}
+
+// showSeason fills in the episodes for a single season
+func (t *TraktTV) showSeason(ctx context.Context, slugOrID, extended string, season *Season) error {
+	args := map[string]string{
+		"Query":  slugOrID,
+		"Season": fmt.Sprintf("%d", season.Number),
+	}
+	apiURL, err := t.getURLWithQuery(ShowSeasonTmpl, args, url.Values{"extended": {extended}})
+	if err != nil {
+		return err
+	}
+	return t.getWithErrorCheck(ctx, apiURL, &season.Episodes)
+}
+
+// MovieSearch searches Trakt.tv for movies matching the query
+func (t *TraktTV) MovieSearch(query string, opts ...CallOption) ([]Movie, error) {
+	return t.MovieSearchContext(context.Background(), query, opts...)
+}
+
+// MovieSearchContext is MovieSearch with a context that can cancel the
+// request
+func (t *TraktTV) MovieSearchContext(ctx context.Context, query string, opts ...CallOption) ([]Movie, error) {
+	params := url.Values{
+		"query":    {query},
+		"extended": {extendedArg(opts)},
+	}
+	res := []Movie{}
+	apiURL, err := t.getURLWithQuery(MovieSearchTmpl, map[string]string{}, params)
+	if err != nil {
+		return res, err
+	}
+	hits := []SearchResult{}
+	err = t.getWithErrorCheck(ctx, apiURL, &hits)
+	for _, h := range hits {
+		if h.Movie != nil {
+			res = append(res, *h.Movie)
+		}
+	}
+	return res, err
+}
+
+// GetMovie returns the summary for a movie given its slug, Trakt or IMDB
+// id.  The summary is ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetMovie(slugOrID string, opts ...CallOption) (*Movie, error) {
+	return t.GetMovieContext(context.Background(), slugOrID, opts...)
+}
+
+// GetMovieContext is GetMovie with a context that can cancel the request
+func (t *TraktTV) GetMovieContext(ctx context.Context, slugOrID string, opts ...CallOption) (*Movie, error) {
+	res := &Movie{}
+	params := url.Values{"extended": {extendedArg(opts, ExtendedFull)}}
+	apiURL, err := t.getURLWithQuery(MovieSummaryTmpl, map[string]string{"Query": slugOrID}, params)
+	if err != nil {
+		return res, err
+	}
+	err = t.getWithErrorCheck(ctx, apiURL, res)
+	return res, err
+}
@@ -0,0 +1,344 @@
+package trakt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fixtureServer returns a test server that answers every request with the
+// contents of the given testdata file.
+func fixtureServer(t *testing.T, fixture string) *httptest.Server {
+	data, err := ioutil.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatalf("Error reading test data: %s", err)
+	}
+	return httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, string(data))
+			}))
+}
+
+// authedClient returns a client for the test server with a valid token
+func authedClient(url string, options ...option) *TraktTV {
+	tok := &Token{AccessToken: "access1", RefreshToken: "refresh1", ExpiresIn: 7776000, CreatedAt: time.Now().Unix()}
+	options = append([]option{Host(url), OAuth("secret", OutOfBandRedirectURI, NewMemoryTokenStore(tok))}, options...)
+	trakt, _ := New("testing", options...)
+	return trakt
+}
+
+func TestHeaders(t *testing.T) {
+	var got *http.Request
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				fmt.Fprintln(w, "[]")
+			}))
+	defer ts.Close()
+
+	trakt, err := New("testingapi", Host(ts.URL))
+	if err != nil {
+		t.Fatalf("Unexpected error when creating new TraktTV: %s", err)
+	}
+	_, err = trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if v := got.Header.Get("trakt-api-version"); v != APIVersion {
+		t.Fatalf("Expected trakt-api-version %q, got %q", APIVersion, v)
+	}
+	if v := got.Header.Get("trakt-api-key"); v != "testingapi" {
+		t.Fatalf("Expected trakt-api-key \"testingapi\", got %q", v)
+	}
+	if strings.Contains(got.URL.String(), "testingapi") {
+		t.Fatalf("API key should not be part of the url: %s", got.URL)
+	}
+}
+
+// recordingDoer records the requests sent through it and answers them with
+// an empty list
+type recordingDoer struct {
+	requests []*http.Request
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("[]")),
+	}, nil
+}
+
+func TestDoerHeaders(t *testing.T) {
+	doer := &recordingDoer{}
+	trakt, _ := New("testingapi", Host("http://trakt.invalid"), Client(doer),
+		OAuth("secret", OutOfBandRedirectURI, NewMemoryTokenStore(&Token{AccessToken: "access1", ExpiresIn: 7200, CreatedAt: time.Now().Unix()})))
+	_, err := trakt.ShowSearch("battlestar")
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(doer.requests) != 1 {
+		t.Fatalf("Expected 1 request through the Doer, got %d", len(doer.requests))
+	}
+	h := doer.requests[0].Header
+	if v := h.Get("trakt-api-version"); v != APIVersion {
+		t.Fatalf("Expected trakt-api-version %q, got %q", APIVersion, v)
+	}
+	if v := h.Get("trakt-api-key"); v != "testingapi" {
+		t.Fatalf("Expected trakt-api-key \"testingapi\", got %q", v)
+	}
+	if v := h.Get("Authorization"); v != "Bearer access1" {
+		t.Fatalf("Expected Authorization \"Bearer access1\", got %q", v)
+	}
+}
+
+func TestTvSearch(t *testing.T) {
+	ts := fixtureServer(t, "battlestar_show_search.json")
+	defer ts.Close()
+
+	trakt, err := New("testing", Host(ts.URL))
+	if err != nil {
+		t.Fatalf("Unexpected error when creating new TraktTV: %s", err)
+	}
+	res, err := trakt.ShowSearch("Battlestar Galactica")
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(res) != 4 {
+		t.Fatalf("Expecting 4 results, got %d", len(res))
+	}
+	if res[0].IDs.Slug != "battlestar-galactica-2003" {
+		t.Fatalf("Unexpected slug for first result: %s", res[0].IDs.Slug)
+	}
+}
+
+func TestErrorHandling(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+			}))
+	defer ts.Close()
+	trakt, err := New("testing", Host(ts.URL))
+	if err != nil {
+		t.Fatalf("Error creating TraktTV: %s", err)
+	}
+	_, err = trakt.GetShow("battlestar-galactica-2003")
+	if err == nil {
+		t.Fatal("Expected to get an error and got none.")
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("Expected ErrUnauthorized, got %#v", err)
+	}
+}
+
+func TestTvSummary(t *testing.T) {
+	summary, err := ioutil.ReadFile("testdata/battlestar_show_summary.json")
+	if err != nil {
+		t.Fatalf("Error reading test data: %s", err)
+	}
+	seasons, err := ioutil.ReadFile("testdata/battlestar_show_seasons.json")
+	if err != nil {
+		t.Fatalf("Error reading test data: %s", err)
+	}
+
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/shows/battlestar-galactica-2003":
+					fmt.Fprintln(w, string(summary))
+				case "/shows/battlestar-galactica-2003/seasons":
+					fmt.Fprintln(w, string(seasons))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+	defer ts.Close()
+	trakt, err := New("testing", Host(ts.URL))
+	if err != nil {
+		t.Fatalf("Error creating TraktTV: %s", err)
+	}
+	tvshow, err := trakt.GetShow("battlestar-galactica-2003")
+	if err != nil {
+		t.Fatalf("Error getting show summary: %s", err)
+	}
+
+	if tvshow.Title != "Battlestar Galactica (2003)" {
+		t.Fatalf("Expecting title of \"Battlestar Galactica (2003)\" got %s", tvshow.Title)
+	}
+	if tvshow.FirstAired.Year() != 2003 {
+		t.Fatalf("Expected first aired in 2003, got %s", tvshow.FirstAired)
+	}
+	if len(tvshow.Seasons) != 2 {
+		t.Fatalf("Expected 2 seasons, got %d", len(tvshow.Seasons))
+	}
+	if len(tvshow.Seasons[1].Episodes) != 3 {
+		t.Fatalf("Expected 3 episodes in season 1, got %d", len(tvshow.Seasons[1].Episodes))
+	}
+}
+
+func TestShowSeasons(t *testing.T) {
+	seasZero, err := ioutil.ReadFile("testdata/battlestar_show_season_0.json")
+	if err != nil {
+		t.Fatalf("Error reading testdata: %s", err)
+	}
+	seasOne, err := ioutil.ReadFile("testdata/battlestar_show_season_1.json")
+	if err != nil {
+		t.Fatalf("Error reading testdata: %s", err)
+	}
+
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/seasons/0"):
+					fmt.Fprintln(w, string(seasZero))
+				case strings.HasSuffix(r.URL.Path, "/seasons/1"):
+					fmt.Fprintln(w, string(seasOne))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+	defer ts.Close()
+
+	trakt, err := New("testing", Host(ts.URL))
+	if err != nil {
+		t.Fatalf("Error creating TraktTV: %s", err)
+	}
+
+	seas, err := trakt.ShowSeasons("battlestar-galactica-2003", []int{0, 1})
+	if err != nil {
+		t.Fatalf("Error getting seasons: %s", err)
+	}
+	if len(seas) != 2 {
+		t.Fatalf("Expected 2 seasons returned, got %d", len(seas))
+	}
+	if len(seas[1].Episodes) != 3 {
+		t.Fatalf("Expected 3 episodes in season 1, got %d", len(seas[1].Episodes))
+	}
+	if seas[1].Episodes[0].Title != "33" {
+		t.Fatalf("Unexpected title for S01E01: %s", seas[1].Episodes[0].Title)
+	}
+}
+
+func TestMovieSearch(t *testing.T) {
+	ts := fixtureServer(t, "batman_movie_search.json")
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+
+	res, err := trakt.MovieSearch("batman")
+	if err != nil {
+		t.Fatalf("Error getting Movie search: %s", err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("Didn't parse response correctly, should have gotten 3 records, got %d", len(res))
+	}
+	if res[0].IDs.Imdb != "tt0096895" {
+		t.Fatalf("Unexpected IMDB value parsed, got %s", res[0].IDs.Imdb)
+	}
+}
+
+func TestMovieSummary(t *testing.T) {
+	ts := fixtureServer(t, "batman_movie_summary.json")
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+
+	m, err := trakt.GetMovie("batman-1989")
+	if err != nil {
+		t.Fatalf("Error getting Movie summary: %s", err)
+	}
+
+	if m.Title != "Batman" {
+		t.Fatalf("Unexpected title: %s", m.Title)
+	}
+	if m.Runtime != 126 {
+		t.Fatalf("Unexpected runtime: %d", m.Runtime)
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-release:
+				}
+			}))
+	defer ts.Close()
+	defer close(release)
+
+	trakt, _ := New("testing", Host(ts.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := trakt.GetShowContext(ctx, "battlestar-galactica-2003")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded error, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("Request wasn't cancelled when the context expired")
+	}
+}
+
+func TestErrorTypes(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/movies/missing":
+					http.NotFound(w, r)
+				case "/movies/bad-json":
+					fmt.Fprintln(w, "{\n\"title\": \"Batman\",\n\"year\": 1989,,\n}")
+				case "/movies/bad-type":
+					fmt.Fprintln(w, `{"title": "Batman", "year": "nineteen eighty nine"}`)
+				}
+			}))
+	defer ts.Close()
+
+	trakt, _ := New("testing", Host(ts.URL))
+	_, err := trakt.GetMovie("missing")
+	var httpErr *HTTPError
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &httpErr) {
+		t.Fatalf("Expected a not found HTTPError, got %#v", err)
+	}
+	if httpErr.StatusCode != http.StatusNotFound || !strings.HasSuffix(httpErr.URL, "/movies/missing?extended=full") {
+		t.Fatalf("HTTPError missing status or url: %#v", httpErr)
+	}
+	if !strings.Contains(httpErr.Body, "404 page not found") {
+		t.Fatalf("HTTPError missing body excerpt: %q", httpErr.Body)
+	}
+
+	var decodeErr *DecodeError
+	_, err = trakt.GetMovie("bad-json")
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected a DecodeError, got %#v", err)
+	}
+	if decodeErr.Line != 3 || !strings.Contains(decodeErr.Highlight, `"year": 1989,,`) {
+		t.Fatalf("DecodeError doesn't point at the syntax error: line %d\n%s", decodeErr.Line, decodeErr.Highlight)
+	}
+
+	_, err = trakt.GetMovie("bad-type")
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected a DecodeError, got %#v", err)
+	}
+
+	_, err = trakt.ShowSeasons("battlestar-galactica-2003", nil)
+	if err != ErrNoSeasons {
+		t.Fatalf("Expected ErrNoSeasons, got %v", err)
+	}
+}
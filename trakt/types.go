@@ -0,0 +1,174 @@
+package trakt
+
+import (
+	"time"
+)
+
+// IDs holds the identifiers Trakt knows an item by.  Which ones are set
+// depends on the type of item.
+type IDs struct {
+	Trakt  int    `json:"trakt,omitempty"`
+	Slug   string `json:"slug,omitempty"`
+	Imdb   string `json:"imdb,omitempty"`
+	Tmdb   int    `json:"tmdb,omitempty"`
+	Tvdb   int    `json:"tvdb,omitempty"`
+	Tvrage int    `json:"tvrage,omitempty"`
+}
+
+// Images are the paths of an item's artwork, filled in when asked for with
+// ExtendedImages.  The paths have no scheme, so prefix them with
+// "https://".  Which kinds are set depends on the type of item.
+type Images struct {
+	Fanart     []string `json:"fanart"`
+	Poster     []string `json:"poster"`
+	Logo       []string `json:"logo"`
+	Clearart   []string `json:"clearart"`
+	Banner     []string `json:"banner"`
+	Thumb      []string `json:"thumb"`
+	Screenshot []string `json:"screenshot"`
+	Headshot   []string `json:"headshot"`
+}
+
+// Airs describes when a show is normally broadcast
+type Airs struct {
+	Day      string `json:"day"`
+	Time     string `json:"time"`
+	Timezone string `json:"timezone"`
+}
+
+// Show is the show result from Trakt
+type Show struct {
+	Title                 string    `json:"title"`
+	Year                  int       `json:"year"`
+	IDs                   IDs       `json:"ids"`
+	Overview              string    `json:"overview"`
+	FirstAired            time.Time `json:"first_aired"`
+	Airs                  Airs      `json:"airs"`
+	Runtime               int       `json:"runtime"`
+	Certification         string    `json:"certification"`
+	Network               string    `json:"network"`
+	Country               string    `json:"country"`
+	Trailer               string    `json:"trailer"`
+	Homepage              string    `json:"homepage"`
+	Status                string    `json:"status"`
+	Rating                float64   `json:"rating"`
+	Votes                 int       `json:"votes"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	Language              string    `json:"language"`
+	AvailableTranslations []string  `json:"available_translations"`
+	Genres                []string  `json:"genres"`
+	AiredEpisodes         int       `json:"aired_episodes"`
+	Images                *Images   `json:"images,omitempty"`
+	Seasons               []Season  `json:"seasons,omitempty"`
+}
+
+// Season is a containter for tv episodes
+type Season struct {
+	Number        int       `json:"number"`
+	IDs           IDs       `json:"ids"`
+	Rating        float64   `json:"rating"`
+	Votes         int       `json:"votes"`
+	EpisodeCount  int       `json:"episode_count"`
+	AiredEpisodes int       `json:"aired_episodes"`
+	Title         string    `json:"title"`
+	Overview      string    `json:"overview"`
+	FirstAired    time.Time `json:"first_aired"`
+	Network       string    `json:"network"`
+	Images        *Images   `json:"images,omitempty"`
+	Episodes      []Episode `json:"episodes"`
+}
+
+// Episode contains the information for a given Show Episode
+type Episode struct {
+	Season                int       `json:"season"`
+	Number                int       `json:"number"`
+	Title                 string    `json:"title"`
+	IDs                   IDs       `json:"ids"`
+	NumberAbs             int       `json:"number_abs"`
+	Overview              string    `json:"overview"`
+	Rating                float64   `json:"rating"`
+	Votes                 int       `json:"votes"`
+	CommentCount          int       `json:"comment_count"`
+	FirstAired            time.Time `json:"first_aired"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	AvailableTranslations []string  `json:"available_translations"`
+	Runtime               int       `json:"runtime"`
+	Images                *Images   `json:"images,omitempty"`
+}
+
+// Movie holds the result of a Movie search from Trakt
+type Movie struct {
+	Title                 string    `json:"title"`
+	Year                  int       `json:"year"`
+	IDs                   IDs       `json:"ids"`
+	Tagline               string    `json:"tagline"`
+	Overview              string    `json:"overview"`
+	Released              string    `json:"released"`
+	Runtime               int       `json:"runtime"`
+	Country               string    `json:"country"`
+	Trailer               string    `json:"trailer"`
+	Homepage              string    `json:"homepage"`
+	Status                string    `json:"status"`
+	Rating                float64   `json:"rating"`
+	Votes                 int       `json:"votes"`
+	CommentCount          int       `json:"comment_count"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	Language              string    `json:"language"`
+	AvailableTranslations []string  `json:"available_translations"`
+	Genres                []string  `json:"genres"`
+	Certification         string    `json:"certification"`
+	Images                *Images   `json:"images,omitempty"`
+}
+
+// User is a Trakt user as shown on their public profile
+type User struct {
+	Username string `json:"username"`
+	Private  bool   `json:"private"`
+	Name     string `json:"name"`
+	VIP      bool   `json:"vip"`
+	IDs      IDs    `json:"ids"`
+}
+
+// Comment is a comment or review on a movie, show, season, episode or list
+type Comment struct {
+	ID        int64     `json:"id"`
+	ParentID  int64     `json:"parent_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Comment   string    `json:"comment"`
+	Spoiler   bool      `json:"spoiler"`
+	Review    bool      `json:"review"`
+	Replies   int       `json:"replies"`
+	Likes     int       `json:"likes"`
+	User      User      `json:"user"`
+}
+
+// Person is an actor or crew member.  Only Name and IDs are set unless
+// the details are asked for with ExtendedFull.
+type Person struct {
+	Name               string    `json:"name"`
+	IDs                IDs       `json:"ids"`
+	Biography          string    `json:"biography"`
+	Birthday           string    `json:"birthday"`
+	Death              string    `json:"death"`
+	Birthplace         string    `json:"birthplace"`
+	Homepage           string    `json:"homepage"`
+	Gender             string    `json:"gender"`
+	KnownForDepartment string    `json:"known_for_department"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	Images             *Images   `json:"images,omitempty"`
+}
+
+// SearchResult is a single hit returned by the search endpoints.  Type
+// says which of the item fields is set.
+type SearchResult struct {
+	// Type is movie, show, episode, person or list
+	Type  string  `json:"type"`
+	Score float64 `json:"score"`
+	Movie *Movie  `json:"movie"`
+	Show  *Show   `json:"show"`
+	// Episode results also have the episode's Show set
+	Episode *Episode `json:"episode"`
+	Person  *Person  `json:"person"`
+	List    *List    `json:"list"`
+}
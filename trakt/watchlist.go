@@ -0,0 +1,139 @@
+package trakt
+
+import (
+	"context"
+	"net/url"
+	"text/template"
+	"time"
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/get-watchlist
+var WatchlistTmpl = template.Must(
+	template.New("Watchlist").Parse("{{.Host}}/sync/watchlist{{if .Type}}/{{.Type | urlquery}}{{if .Sort}}/{{.Sort | urlquery}}{{end}}{{end}}"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/add-to-watchlist
+var AddToWatchlistTmpl = template.Must(
+	template.New("AddToWatchlist").Parse("{{.Host}}/sync/watchlist"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/remove-from-watchlist
+var RemoveFromWatchlistTmpl = template.Must(
+	template.New("RemoveFromWatchlist").Parse("{{.Host}}/sync/watchlist/remove"),
+)
+
+// https://trakt.docs.apiary.io/#reference/sync/reorder-watchlist
+var ReorderWatchlistTmpl = template.Must(
+	template.New("ReorderWatchlist").Parse("{{.Host}}/sync/watchlist/reorder"),
+)
+
+// Orders a watchlist can be sorted in
+const (
+	SortRank     = "rank"
+	SortAdded    = "added"
+	SortReleased = "released"
+	SortTitle    = "title"
+)
+
+// WatchlistItem is an entry on the user's watchlist
+type WatchlistItem struct {
+	// ID identifies the entry, for ReorderWatchlist
+	ID       int64     `json:"id"`
+	Rank     int       `json:"rank"`
+	ListedAt time.Time `json:"listed_at"`
+	Notes    string    `json:"notes"`
+	// Type is movie, show, season or episode
+	Type    string   `json:"type"`
+	Movie   *Movie   `json:"movie"`
+	Show    *Show    `json:"show"`
+	Season  *Season  `json:"season"`
+	Episode *Episode `json:"episode"`
+}
+
+// WatchlistOptions filters, sorts and pages the results of GetWatchlist.
+// Zero values are left out of the request.
+type WatchlistOptions struct {
+	// Type is movies, shows, seasons or episodes
+	Type string
+	// Sort is one of the Sort constants.  Sorting without a Type sorts
+	// all of the entries.
+	Sort  string
+	Page  int
+	Limit int
+}
+
+// ReorderResult is Trakt's answer to reordering a list
+type ReorderResult struct {
+	Updated int `json:"updated"`
+	// SkippedIDs are ids that weren't on the list
+	SkippedIDs []int64 `json:"skipped_ids"`
+}
+
+// GetWatchlist returns the user's watchlist along with the pagination
+// Trakt returned, which is nil unless a page or limit was asked for.  The
+// items are ExtendedFull unless ExtendedInfo says otherwise.
+func (t *TraktTV) GetWatchlist(opts WatchlistOptions, callOpts ...CallOption) ([]WatchlistItem, *Pagination, error) {
+	return t.GetWatchlistContext(context.Background(), opts, callOpts...)
+}
+
+// GetWatchlistContext is GetWatchlist with a context that can cancel the
+// request
+func (t *TraktTV) GetWatchlistContext(ctx context.Context, opts WatchlistOptions, callOpts ...CallOption) ([]WatchlistItem, *Pagination, error) {
+	args := map[string]string{
+		"Type": opts.Type,
+		"Sort": opts.Sort,
+	}
+	if opts.Sort != "" && opts.Type == "" {
+		args["Type"] = "all"
+	}
+	params := url.Values{"extended": {extendedArg(callOpts, ExtendedFull)}}
+	pageParams(params, opts.Page, opts.Limit)
+	res := []WatchlistItem{}
+	apiURL, err := t.getURLWithQuery(WatchlistTmpl, args, params)
+	if err != nil {
+		return res, nil, err
+	}
+	h, err := t.getAuthenticated(ctx, apiURL, &res)
+	return res, parsePagination(h), err
+}
+
+// AddToWatchlist adds the items to the user's watchlist
+func (t *TraktTV) AddToWatchlist(items *SyncItems) (*SyncResult, error) {
+	return t.AddToWatchlistContext(context.Background(), items)
+}
+
+// AddToWatchlistContext is AddToWatchlist with a context that can cancel
+// the request
+func (t *TraktTV) AddToWatchlistContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, AddToWatchlistTmpl, items)
+}
+
+// RemoveFromWatchlist removes the items from the user's watchlist
+func (t *TraktTV) RemoveFromWatchlist(items *SyncItems) (*SyncResult, error) {
+	return t.RemoveFromWatchlistContext(context.Background(), items)
+}
+
+// RemoveFromWatchlistContext is RemoveFromWatchlist with a context that can
+// cancel the request
+func (t *TraktTV) RemoveFromWatchlistContext(ctx context.Context, items *SyncItems) (*SyncResult, error) {
+	return t.sync(ctx, RemoveFromWatchlistTmpl, items)
+}
+
+// ReorderWatchlist sets the rank of the watchlist entries to the order of
+// their ids, as given by WatchlistItem.ID
+func (t *TraktTV) ReorderWatchlist(ids []int64) (*ReorderResult, error) {
+	return t.ReorderWatchlistContext(context.Background(), ids)
+}
+
+// ReorderWatchlistContext is ReorderWatchlist with a context that can
+// cancel the request
+func (t *TraktTV) ReorderWatchlistContext(ctx context.Context, ids []int64) (*ReorderResult, error) {
+	res := &ReorderResult{}
+	apiURL, err := t.getURLFromTemplate(ReorderWatchlistTmpl, map[string]string{})
+	if err != nil {
+		return res, err
+	}
+	payload := map[string][]int64{"rank": ids}
+	err = t.sendAuthenticated(ctx, "POST", apiURL, payload, res)
+	return res, err
+}
@@ -0,0 +1,85 @@
+package trakt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetWatchlist(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sync/watchlist/movies/released" {
+					t.Errorf("Unexpected request: %s", r.URL)
+				}
+				fmt.Fprintln(w, `[{"rank":1,"id":101,"listed_at":"2014-09-01T09:10:11.000Z","notes":null,"type":"movie","movie":{"title":"Batman","year":1989,"ids":{"trakt":224}}},{"rank":2,"id":102,"listed_at":"2014-09-01T09:10:11.000Z","notes":"Sequel","type":"movie","movie":{"title":"Batman Returns","year":1992,"ids":{"trakt":225}}}]`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	items, page, err := trakt.GetWatchlist(WatchlistOptions{Type: "movies", Sort: SortReleased})
+	if err != nil {
+		t.Fatalf("Error getting watchlist: %s", err)
+	}
+	if len(items) != 2 || items[1].Movie.Title != "Batman Returns" || items[1].Notes != "Sequel" || items[1].ID != 102 {
+		t.Fatalf("Unexpected watchlist: %#v", items)
+	}
+	if page != nil {
+		t.Fatalf("Expected no pagination for an unpaged request, got %#v", page)
+	}
+}
+
+func TestReorderWatchlist(t *testing.T) {
+	var posted map[string][]int64
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/sync/watchlist/reorder" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				json.NewDecoder(r.Body).Decode(&posted)
+				fmt.Fprintln(w, `{"updated":2,"skipped_ids":[999]}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	res, err := trakt.ReorderWatchlist([]int64{102, 101, 999})
+	if err != nil {
+		t.Fatalf("Error reordering watchlist: %s", err)
+	}
+	if res.Updated != 2 || len(res.SkippedIDs) != 1 || res.SkippedIDs[0] != 999 {
+		t.Fatalf("Unexpected result: %#v", res)
+	}
+	if len(posted["rank"]) != 3 || posted["rank"][0] != 102 {
+		t.Fatalf("Unexpected body posted: %#v", posted)
+	}
+}
+
+func TestAddToWatchlist(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/sync/watchlist" {
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL)
+				}
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprintln(w, `{"added":{"movies":1,"shows":1,"seasons":0,"episodes":0},"existing":{"movies":0,"shows":0,"seasons":0,"episodes":0},"not_found":{"movies":[],"shows":[],"seasons":[],"episodes":[]}}`)
+			}))
+	defer ts.Close()
+
+	trakt := authedClient(ts.URL)
+	items := (&SyncItems{}).
+		AddMovie(&Movie{IDs: IDs{Trakt: 224}}, time.Time{}).
+		AddShow(&Show{IDs: IDs{Slug: "breaking-bad"}}, time.Time{})
+	res, err := trakt.AddToWatchlist(items)
+	if err != nil {
+		t.Fatalf("Error adding to watchlist: %s", err)
+	}
+	if res.Added.Movies != 1 || res.Added.Shows != 1 {
+		t.Fatalf("Unexpected counts: %#v", res.Added)
+	}
+}